@@ -79,11 +79,11 @@ func runTUI(cmd *cobra.Command, args []string) {
 
 	vPath := cfg.VaultPath
 	if vaultPath != "" {
-		vPath = vaultPath
+		vPath = config.ExpandPath(vaultPath)
 	}
 
 	app := ui.NewApp(vPath, cfg)
-	p := tea.NewProgram(app, tea.WithAltScreen())
+	p := tea.NewProgram(app, tea.WithAltScreen(), tea.WithReportFocus())
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT)
@@ -121,7 +121,7 @@ var backupCmd = &cobra.Command{
 		cfg, _ := config.Load()
 		vPath := cfg.VaultPath
 		if vaultPath != "" {
-			vPath = vaultPath
+			vPath = config.ExpandPath(vaultPath)
 		}
 		handleBackup(vPath, args[0])
 	},
@@ -141,7 +141,7 @@ var restoreCmd = &cobra.Command{
 		cfg, _ := config.Load()
 		vPath := cfg.VaultPath
 		if vaultPath != "" {
-			vPath = vaultPath
+			vPath = config.ExpandPath(vaultPath)
 		}
 		handleRestore(args[0], vPath)
 	},
@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
 )
 
 // Config holds the application configuration.
@@ -14,6 +16,10 @@ type Config struct {
 	VaultPath string `json:"vault_path"`
 	// AutoLockTimeout is the auto-lock timeout in seconds.
 	AutoLockTimeout int `json:"auto_lock_timeout"`
+	// LockMode selects whether AutoLockTimeout counts down from the last
+	// activity (utils.LockModeIdle, the default) or once from unlock
+	// regardless of activity (utils.LockModeAbsolute).
+	LockMode utils.LockMode `json:"lock_mode"`
 	// ClipboardTimeout is the clipboard auto-clear timeout in seconds.
 	ClipboardTimeout int `json:"clipboard_timeout"`
 	// AutoBackup enables automatic backups after changes.
@@ -24,10 +30,34 @@ type Config struct {
 	BackupDirectory string `json:"backup_directory"`
 	// Theme is the UI theme name.
 	Theme string `json:"theme"`
+	// LockOnBlur locks the vault when the terminal loses focus, where the
+	// terminal reports focus events. It's a documented no-op otherwise.
+	LockOnBlur bool `json:"lock_on_blur"`
+	// BackupBeforeSave writes a rolling ".bak" copy of the vault file before
+	// every save, so a botched write is recoverable.
+	BackupBeforeSave bool `json:"backup_before_save"`
 	// PasswordGenerator contains password generator settings.
 	PasswordGenerator PasswordGeneratorConfig `json:"password_generator"`
+	// TOTPCopyMode controls what pressing the TOTP copy key copies.
+	TOTPCopyMode TOTPCopyMode `json:"totp_copy_mode"`
+	// Presets holds user-defined password generator presets, keyed by name.
+	// GeneratorPreset checks these before falling back to the built-in
+	// presets from DefaultPresets.
+	Presets map[string]PasswordGeneratorConfig `json:"presets,omitempty"`
 }
 
+// TOTPCopyMode selects what the TOTP copy action puts on the clipboard.
+type TOTPCopyMode string
+
+const (
+	// TOTPCopyCode copies the current 6-digit code (the default).
+	TOTPCopyCode TOTPCopyMode = "code"
+	// TOTPCopySecret copies the raw shared secret.
+	TOTPCopySecret TOTPCopyMode = "secret"
+	// TOTPCopyOTPAuthURL copies the otpauth:// setup URL.
+	TOTPCopyOTPAuthURL TOTPCopyMode = "otpauth_url"
+)
+
 // PasswordGeneratorConfig holds password generator settings.
 type PasswordGeneratorConfig struct {
 	DefaultLength    int  `json:"default_length"`
@@ -36,6 +66,13 @@ type PasswordGeneratorConfig struct {
 	IncludeDigits    bool `json:"include_digits"`
 	IncludeSymbols   bool `json:"include_symbols"`
 	ExcludeAmbiguous bool `json:"exclude_ambiguous"`
+	// AmbiguousChars overrides which characters ExcludeAmbiguous strips.
+	// Empty means utils.GeneratorOptions' package default (0O1lI).
+	AmbiguousChars string `json:"ambiguous_chars,omitempty"`
+	// MinGeneratedEntropyBits is the minimum estimated entropy a generated
+	// password must reach; length is auto-extended (up to
+	// utils.MaxPasswordLength) until it is met. Zero disables enforcement.
+	MinGeneratedEntropyBits float64 `json:"min_generated_entropy_bits"`
 }
 
 // DefaultConfig returns the default configuration.
@@ -43,11 +80,13 @@ func DefaultConfig() *Config {
 	return &Config{
 		VaultPath:          DefaultVaultPath(),
 		AutoLockTimeout:    DefaultAutoLockTimeout,
+		LockMode:           utils.LockModeIdle,
 		ClipboardTimeout:   DefaultClipboardTimeout,
 		AutoBackup:         true,
 		BackupReminderDays: DefaultBackupReminderDays,
 		BackupDirectory:    DefaultBackupDir(),
 		Theme:              "default",
+		TOTPCopyMode:       TOTPCopyCode,
 		PasswordGenerator: PasswordGeneratorConfig{
 			DefaultLength:    16,
 			IncludeUpper:     true,
@@ -77,6 +116,9 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	config.VaultPath = ExpandPath(config.VaultPath)
+	config.BackupDirectory = ExpandPath(config.BackupDirectory)
+
 	return config, nil
 }
 
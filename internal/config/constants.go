@@ -39,6 +39,16 @@ const (
 	DefaultPasswordLength = 16
 )
 
+// Password age thresholds, used to nudge rotation of old passwords.
+const (
+	// PasswordAgeFreshDays is the age below which a password is considered
+	// fresh and needs no attention.
+	PasswordAgeFreshDays = 90
+	// PasswordAgeAgingDays is the age below which a password is considered
+	// aging (worth rotating soon) rather than old (overdue).
+	PasswordAgeAgingDays = 180
+)
+
 // File permissions
 const (
 	// VaultFileMode is the file mode for vault files (owner read/write only).
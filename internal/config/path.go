@@ -0,0 +1,40 @@
+// Package config provides configuration management for the password manager.
+package config
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// percentVarPattern matches Windows-style %VAR% environment variable
+// references.
+var percentVarPattern = regexp.MustCompile(`%([A-Za-z_][A-Za-z0-9_]*)%`)
+
+// ExpandPath expands a leading "~" to the user's home directory and any
+// $VAR, ${VAR}, or %VAR% environment variable references in p. An
+// already-absolute path with no such references is returned unchanged.
+// Used for config fields like VaultPath and BackupDirectory that users
+// often set as "~/vaults/main.c0" or "$HOME/vaults/main.c0".
+func ExpandPath(p string) string {
+	if p == "" {
+		return p
+	}
+
+	if p == "~" || strings.HasPrefix(p, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			p = home + strings.TrimPrefix(p, "~")
+		}
+	}
+
+	p = os.ExpandEnv(p)
+	p = percentVarPattern.ReplaceAllStringFunc(p, func(match string) string {
+		name := match[1 : len(match)-1]
+		if val, ok := os.LookupEnv(name); ok {
+			return val
+		}
+		return match
+	})
+
+	return p
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"os"
+	"testing"
+)
+
+func TestExpandPathExpandsHomeTilde(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory available: %v", err)
+	}
+
+	got := ExpandPath("~/vaults/main.c0")
+	want := home + "/vaults/main.c0"
+	if got != want {
+		t.Errorf("Expected ~ to expand to the home directory, got %q want %q", got, want)
+	}
+}
+
+func TestExpandPathExpandsEnvVar(t *testing.T) {
+	t.Setenv("CIPHER0_TEST_DIR", "/tmp/cipher0-test")
+
+	got := ExpandPath("$CIPHER0_TEST_DIR/main.c0")
+	want := "/tmp/cipher0-test/main.c0"
+	if got != want {
+		t.Errorf("Expected $VAR to expand, got %q want %q", got, want)
+	}
+}
+
+func TestExpandPathLeavesAbsolutePathUnchanged(t *testing.T) {
+	path := "/var/lib/cipher0/main.c0"
+	if got := ExpandPath(path); got != path {
+		t.Errorf("Expected an already-absolute path to be unchanged, got %q", got)
+	}
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
+)
+
+// Built-in preset names for GeneratorPreset.
+const (
+	PresetPIN       = "pin"
+	PresetStrongWeb = "strong-web"
+	PresetWifi      = "wifi"
+)
+
+// DefaultPresets returns the built-in password generator presets.
+func DefaultPresets() map[string]PasswordGeneratorConfig {
+	return map[string]PasswordGeneratorConfig{
+		PresetPIN: {
+			DefaultLength: 6,
+			IncludeDigits: true,
+		},
+		PresetStrongWeb: {
+			DefaultLength:    20,
+			IncludeUpper:     true,
+			IncludeLower:     true,
+			IncludeDigits:    true,
+			IncludeSymbols:   true,
+			ExcludeAmbiguous: true,
+		},
+		PresetWifi: {
+			DefaultLength:  24,
+			IncludeUpper:   true,
+			IncludeLower:   true,
+			IncludeDigits:  true,
+			IncludeSymbols: false,
+		},
+	}
+}
+
+// toGeneratorOptions converts a PasswordGeneratorConfig to the
+// utils.GeneratorOptions the password generator actually consumes.
+func (p PasswordGeneratorConfig) toGeneratorOptions() utils.GeneratorOptions {
+	return utils.GeneratorOptions{
+		Length:           p.DefaultLength,
+		IncludeUppercase: p.IncludeUpper,
+		IncludeLowercase: p.IncludeLower,
+		IncludeDigits:    p.IncludeDigits,
+		IncludeSymbols:   p.IncludeSymbols,
+		ExcludeAmbiguous: p.ExcludeAmbiguous,
+		AmbiguousChars:   p.AmbiguousChars,
+	}
+}
+
+// GeneratorPreset resolves a named password generator preset to generator
+// options, checking c.Presets first and falling back to the built-in
+// presets (PresetPIN, PresetStrongWeb, PresetWifi). It returns an error if
+// name matches no preset in either.
+func (c *Config) GeneratorPreset(name string) (utils.GeneratorOptions, error) {
+	if c.Presets != nil {
+		if p, ok := c.Presets[name]; ok {
+			return p.toGeneratorOptions(), nil
+		}
+	}
+	if p, ok := DefaultPresets()[name]; ok {
+		return p.toGeneratorOptions(), nil
+	}
+	return utils.GeneratorOptions{}, fmt.Errorf("unknown password generator preset: %q", name)
+}
@@ -0,0 +1,41 @@
+package config
+
+import "testing"
+
+func TestGeneratorPresetResolvesBuiltins(t *testing.T) {
+	c := &Config{}
+
+	for _, name := range []string{PresetPIN, PresetStrongWeb, PresetWifi} {
+		opts, err := c.GeneratorPreset(name)
+		if err != nil {
+			t.Fatalf("GeneratorPreset(%q) failed: %v", name, err)
+		}
+		if opts.Length == 0 {
+			t.Errorf("Expected preset %q to set a non-zero length", name)
+		}
+	}
+}
+
+func TestGeneratorPresetUnknownNameErrors(t *testing.T) {
+	c := &Config{}
+
+	if _, err := c.GeneratorPreset("does-not-exist"); err == nil {
+		t.Error("Expected an unknown preset name to return an error")
+	}
+}
+
+func TestGeneratorPresetPrefersUserDefinedOverBuiltin(t *testing.T) {
+	c := &Config{
+		Presets: map[string]PasswordGeneratorConfig{
+			PresetPIN: {DefaultLength: 10, IncludeDigits: true},
+		},
+	}
+
+	opts, err := c.GeneratorPreset(PresetPIN)
+	if err != nil {
+		t.Fatalf("GeneratorPreset failed: %v", err)
+	}
+	if opts.Length != 10 {
+		t.Errorf("Expected the user-defined preset to override the built-in, got length %d", opts.Length)
+	}
+}
@@ -0,0 +1,51 @@
+package crypto
+
+// DecryptDiagnosis classifies why Decrypt failed, to help a user tell a
+// wrong master password apart from a corrupted vault file.
+type DecryptDiagnosis int
+
+const (
+	// DiagnosisOK means ciphertext decrypted successfully with key.
+	DiagnosisOK DecryptDiagnosis = iota
+	// DiagnosisStructurallyPlausible means ciphertext is at least long
+	// enough to be a valid nonce+ciphertext+tag, but failed GCM
+	// authentication. AES-GCM's authentication tag makes a wrong key and a
+	// tampered/bit-flipped ciphertext of the same length indistinguishable
+	// at this layer, so this diagnosis covers both — it's a hint ("likely
+	// wrong password"), not a guarantee.
+	DiagnosisStructurallyPlausible
+	// DiagnosisMalformed means ciphertext is too short to contain a nonce
+	// and auth tag at all, regardless of key. This case doesn't suffer the
+	// wrong-key/tampered ambiguity above, since no key could ever decrypt
+	// it.
+	DiagnosisMalformed
+)
+
+// String returns a human-readable label for d.
+func (d DecryptDiagnosis) String() string {
+	switch d {
+	case DiagnosisOK:
+		return "OK"
+	case DiagnosisStructurallyPlausible:
+		return "structurally plausible, authentication failed (likely wrong password)"
+	case DiagnosisMalformed:
+		return "malformed (likely corrupted or truncated)"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifyDecryptError diagnoses why Decrypt(ciphertext, key) would fail (or
+// report success), distinguishing ciphertext that's too short to ever
+// decrypt from ciphertext that's a plausible shape but fails authentication.
+func ClassifyDecryptError(ciphertext, key []byte) DecryptDiagnosis {
+	if len(ciphertext) < NonceSize+16 {
+		return DiagnosisMalformed
+	}
+
+	if _, err := Decrypt(ciphertext, key); err != nil {
+		return DiagnosisStructurallyPlausible
+	}
+
+	return DiagnosisOK
+}
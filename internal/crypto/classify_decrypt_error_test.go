@@ -0,0 +1,65 @@
+package crypto
+
+import "testing"
+
+func TestClassifyDecryptErrorTruncatedBlobIsMalformed(t *testing.T) {
+	key := make([]byte, KeySize)
+	truncated := []byte{1, 2, 3}
+
+	if got := ClassifyDecryptError(truncated, key); got != DiagnosisMalformed {
+		t.Fatalf("Expected DiagnosisMalformed, got %v", got)
+	}
+}
+
+func TestClassifyDecryptErrorTamperedTagIsPlausible(t *testing.T) {
+	key := make([]byte, KeySize)
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	ciphertext, err := Encrypt([]byte("hello world"), key)
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+	ciphertext[len(ciphertext)-1] ^= 0xFF
+
+	if got := ClassifyDecryptError(ciphertext, key); got != DiagnosisStructurallyPlausible {
+		t.Fatalf("Expected DiagnosisStructurallyPlausible, got %v", got)
+	}
+}
+
+func TestClassifyDecryptErrorWrongKeyIsPlausible(t *testing.T) {
+	rightKey := make([]byte, KeySize)
+	for i := range rightKey {
+		rightKey[i] = byte(i)
+	}
+	wrongKey := make([]byte, KeySize)
+	for i := range wrongKey {
+		wrongKey[i] = byte(i + 1)
+	}
+
+	ciphertext, err := Encrypt([]byte("hello world"), rightKey)
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+
+	if got := ClassifyDecryptError(ciphertext, wrongKey); got != DiagnosisStructurallyPlausible {
+		t.Fatalf("Expected DiagnosisStructurallyPlausible, got %v", got)
+	}
+}
+
+func TestClassifyDecryptErrorCorrectKeyIsOK(t *testing.T) {
+	key := make([]byte, KeySize)
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	ciphertext, err := Encrypt([]byte("hello world"), key)
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+
+	if got := ClassifyDecryptError(ciphertext, key); got != DiagnosisOK {
+		t.Fatalf("Expected DiagnosisOK, got %v", got)
+	}
+}
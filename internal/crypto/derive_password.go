@@ -0,0 +1,64 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
+)
+
+// ErrDerivePasswordFailed is returned when deterministic password derivation
+// fails, e.g. due to an unreadable HKDF stream.
+var ErrDerivePasswordFailed = errors.New("failed to derive deterministic password")
+
+// DerivePassword deterministically derives a site password from mek using
+// HKDF-SHA256, so the same mek, site, username, length, and opts always
+// yield the same password without it ever being stored. It draws from the
+// charset opts specifies (see utils.BuildCharset), ignoring opts.Length in
+// favor of the length parameter.
+func DerivePassword(mek []byte, site, username string, length int, opts utils.GeneratorOptions) (string, error) {
+	if length < 1 {
+		length = 16
+	}
+
+	opts.Length = length
+	charset := utils.BuildCharset(opts)
+
+	info := []byte(site + "\x00" + username)
+	reader := hkdf.New(sha256.New, mek, nil, info)
+
+	password := make([]byte, length)
+	for i := 0; i < length; i++ {
+		idx, err := readUnbiasedIndex(reader, len(charset))
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrDerivePasswordFailed, err)
+		}
+		password[i] = charset[idx]
+	}
+
+	return string(password), nil
+}
+
+// readUnbiasedIndex reads bytes from r, rejecting values past the largest
+// multiple of n that fits in a byte, so the resulting index in [0, n) is
+// uniformly distributed.
+func readUnbiasedIndex(r io.Reader, n int) (int, error) {
+	if n <= 0 {
+		return 0, errors.New("empty charset")
+	}
+
+	limit := 256 - (256 % n)
+	buf := make([]byte, 1)
+	for {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return 0, err
+		}
+		if int(buf[0]) < limit {
+			return int(buf[0]) % n, nil
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
+)
+
+func TestDerivePasswordDeterministic(t *testing.T) {
+	mek := []byte("01234567890123456789012345678901")
+	opts := utils.DefaultGeneratorOptions()
+
+	p1, err := DerivePassword(mek, "example.com", "alice", 16, opts)
+	if err != nil {
+		t.Fatalf("DerivePassword failed: %v", err)
+	}
+	p2, err := DerivePassword(mek, "example.com", "alice", 16, opts)
+	if err != nil {
+		t.Fatalf("DerivePassword failed: %v", err)
+	}
+
+	if p1 != p2 {
+		t.Errorf("Expected deterministic output, got %q and %q", p1, p2)
+	}
+}
+
+func TestDerivePasswordCharsetCompliance(t *testing.T) {
+	mek := []byte("01234567890123456789012345678901")
+	opts := utils.GeneratorOptions{IncludeDigits: true}
+
+	password, err := DerivePassword(mek, "example.com", "alice", 20, opts)
+	if err != nil {
+		t.Fatalf("DerivePassword failed: %v", err)
+	}
+	if len(password) != 20 {
+		t.Errorf("Expected length 20, got %d", len(password))
+	}
+	if strings.Trim(password, "0123456789") != "" {
+		t.Errorf("Expected digits-only password, got %q", password)
+	}
+}
+
+func TestDerivePasswordChangesWithInputs(t *testing.T) {
+	mek1 := []byte("01234567890123456789012345678901")
+	mek2 := []byte("11234567890123456789012345678901")
+	opts := utils.DefaultGeneratorOptions()
+
+	base, err := DerivePassword(mek1, "example.com", "alice", 16, opts)
+	if err != nil {
+		t.Fatalf("DerivePassword failed: %v", err)
+	}
+
+	if p, err := DerivePassword(mek2, "example.com", "alice", 16, opts); err != nil {
+		t.Fatalf("DerivePassword failed: %v", err)
+	} else if p == base {
+		t.Error("Expected a different MEK to change the output")
+	}
+
+	if p, err := DerivePassword(mek1, "other.com", "alice", 16, opts); err != nil {
+		t.Fatalf("DerivePassword failed: %v", err)
+	} else if p == base {
+		t.Error("Expected a different site to change the output")
+	}
+
+	if p, err := DerivePassword(mek1, "example.com", "bob", 16, opts); err != nil {
+		t.Fatalf("DerivePassword failed: %v", err)
+	} else if p == base {
+		t.Error("Expected a different username to change the output")
+	}
+}
@@ -0,0 +1,103 @@
+package crypto
+
+// fillDuressSlot populates bundle's duress slot: a real decoy MEK wrapped
+// under duressPassword if one is given, or indistinguishable random filler
+// of the exact same shape otherwise (see MEKBundle.SaltDuress). Called by
+// createMEKBundle so every bundle -- not just ones created through
+// CreateDuressBundle -- has the slot populated, which is what keeps an
+// unconfigured bundle indistinguishable from a configured one.
+func fillDuressSlot(bundle *MEKBundle, duressPassword string) error {
+	if duressPassword == "" {
+		saltDuress, err := GenerateRandomBytes(SaltSize)
+		if err != nil {
+			return err
+		}
+		encryptedMEKDuress, err := GenerateRandomBytes(encryptedMEKLength)
+		if err != nil {
+			return err
+		}
+		bundle.SaltDuress = saltDuress
+		bundle.EncryptedMEKDuress = encryptedMEKDuress
+		return nil
+	}
+
+	_, err := bundle.SetDuressPassword(duressPassword)
+	return err
+}
+
+// SetDuressPassword generates a fresh decoy MEK, wraps it under
+// duressPassword, and stores it in the bundle's duress slot, overwriting
+// whatever was there before (real or filler). Returns the decoy MEK so the
+// caller can use it to encrypt the decoy vault's own data; the bundle only
+// ever stores the wrapped key, never the MEK itself.
+func (b *MEKBundle) SetDuressPassword(duressPassword string) ([]byte, error) {
+	decoyMEK, err := GenerateMEK()
+	if err != nil {
+		return nil, err
+	}
+
+	saltDuress, err := GenerateSalt()
+	if err != nil {
+		return nil, err
+	}
+
+	duressKey := DeriveKey([]byte(duressPassword), saltDuress)
+	defer ZeroMemory(duressKey)
+
+	encryptedMEKDuress, err := EncryptMEK(decoyMEK, duressKey)
+	if err != nil {
+		return nil, err
+	}
+
+	b.SaltDuress = saltDuress
+	b.EncryptedMEKDuress = encryptedMEKDuress
+
+	return decoyMEK, nil
+}
+
+// CreateDuressBundle is CreateMEKBundle plus a real decoy MEK wrapped under
+// duressPassword in the bundle's duress slot: entering duressPassword
+// instead of realPassword unlocks a decoy vault built on that MEK instead
+// of the real one (see UnlockEither). The two MEKs and their salts are
+// generated completely independently, so neither password can be used to
+// derive the other's MEK. Returns the bundle, the decoy MEK (the caller
+// needs it to encrypt the decoy vault's own data), and the real password's
+// recovery phrase (which must be shown to the user) -- the decoy vault has
+// no recovery phrase of its own, since losing access to a decoy isn't a
+// risk worth designing around.
+func CreateDuressBundle(realPassword, duressPassword string) (bundle *MEKBundle, decoyMEK []byte, phrase string, err error) {
+	bundle, phrase, err = createMEKBundle(realPassword, false, "")
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	decoyMEK, err = bundle.SetDuressPassword(duressPassword)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return bundle, decoyMEK, phrase, nil
+}
+
+// UnlockEither tries password against the real password slot first and, if
+// that fails, against the duress slot, so the caller never has to ask "is
+// this the duress password" -- the two cases are handled by one call and
+// look identical from outside. Every bundle has a duress slot (see
+// fillDuressSlot), so this always attempts both regardless of whether a
+// duress password was ever actually configured; against an unconfigured
+// bundle the duress attempt simply fails the same way a wrong password
+// would. isDuress reports which slot matched. Returns
+// ErrMEKDecryptionFailed if password matches neither slot.
+func (b *MEKBundle) UnlockEither(password string) (mek []byte, isDuress bool, err error) {
+	if mek, err := b.DecryptMEKWithPassword(password); err == nil {
+		return mek, false, nil
+	}
+
+	key := DeriveKey([]byte(password), b.SaltDuress)
+	defer ZeroMemory(key)
+	if mek, err := DecryptMEK(b.EncryptedMEKDuress, key); err == nil {
+		return mek, true, nil
+	}
+
+	return nil, false, ErrMEKDecryptionFailed
+}
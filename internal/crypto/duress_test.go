@@ -0,0 +1,100 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDuressBundleEachPasswordOpensItsOwnVault(t *testing.T) {
+	_, cleanup := UseMockKeyring()
+	defer cleanup()
+
+	const realPassword = "correct horse battery staple"
+	const duressPassword = "decoy garden umbrella lamp"
+
+	bundle, _, _, err := CreateDuressBundle(realPassword, duressPassword)
+	if err != nil {
+		t.Fatalf("CreateDuressBundle failed: %v", err)
+	}
+
+	realMEK, realIsDuress, err := bundle.UnlockEither(realPassword)
+	if err != nil {
+		t.Fatalf("UnlockEither(realPassword) failed: %v", err)
+	}
+	if realIsDuress {
+		t.Error("Expected realPassword to unlock the real slot, not the duress slot")
+	}
+
+	duressMEK, duressIsDuress, err := bundle.UnlockEither(duressPassword)
+	if err != nil {
+		t.Fatalf("UnlockEither(duressPassword) failed: %v", err)
+	}
+	if !duressIsDuress {
+		t.Error("Expected duressPassword to unlock the duress slot")
+	}
+
+	if bytes.Equal(realMEK, duressMEK) {
+		t.Error("Expected the real and decoy MEKs to be different")
+	}
+}
+
+func TestDuressBundleNeitherPasswordDerivesTheOther(t *testing.T) {
+	_, cleanup := UseMockKeyring()
+	defer cleanup()
+
+	bundle, _, _, err := CreateDuressBundle("real-password-value", "duress-password-value")
+	if err != nil {
+		t.Fatalf("CreateDuressBundle failed: %v", err)
+	}
+
+	if _, err := bundle.DecryptMEKWithPassword("duress-password-value"); err == nil {
+		t.Error("Expected the duress password to fail against the real MEK slot")
+	}
+
+	duressKey := DeriveKey([]byte("real-password-value"), bundle.SaltDuress)
+	if _, err := DecryptMEK(bundle.EncryptedMEKDuress, duressKey); err == nil {
+		t.Error("Expected the real password to fail against the duress MEK slot")
+	}
+}
+
+func TestUnlockEitherFailsForUnknownPassword(t *testing.T) {
+	_, cleanup := UseMockKeyring()
+	defer cleanup()
+
+	bundle, _, _, err := CreateDuressBundle("real-password-value", "duress-password-value")
+	if err != nil {
+		t.Fatalf("CreateDuressBundle failed: %v", err)
+	}
+
+	if _, _, err := bundle.UnlockEither("some-other-password"); err != ErrMEKDecryptionFailed {
+		t.Fatalf("Expected ErrMEKDecryptionFailed, got %v", err)
+	}
+}
+
+func TestBundleWithoutDuressHasConstantSizedDuressSlot(t *testing.T) {
+	_, cleanup := UseMockKeyring()
+	defer cleanup()
+
+	plainBundle, _, err := CreateMEKBundle("just-a-password")
+	if err != nil {
+		t.Fatalf("CreateMEKBundle failed: %v", err)
+	}
+
+	duressBundle, _, _, err := CreateDuressBundle("just-a-password", "a-duress-password")
+	if err != nil {
+		t.Fatalf("CreateDuressBundle failed: %v", err)
+	}
+
+	if len(plainBundle.SaltDuress) != len(duressBundle.SaltDuress) {
+		t.Errorf("Expected SaltDuress to be the same length whether or not a duress password is configured, got %d vs %d",
+			len(plainBundle.SaltDuress), len(duressBundle.SaltDuress))
+	}
+	if len(plainBundle.EncryptedMEKDuress) != len(duressBundle.EncryptedMEKDuress) {
+		t.Errorf("Expected EncryptedMEKDuress to be the same length whether or not a duress password is configured, got %d vs %d",
+			len(plainBundle.EncryptedMEKDuress), len(duressBundle.EncryptedMEKDuress))
+	}
+
+	if _, _, err := plainBundle.UnlockEither("a-duress-password"); err != ErrMEKDecryptionFailed {
+		t.Fatalf("Expected a plain bundle's random duress filler to reject every password, got %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package crypto
+
+import (
+	"time"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// benchmarkPassword and benchmarkSalt are fixed inputs for BenchmarkKDF; the
+// timing only depends on params, not on these values.
+var (
+	benchmarkPassword = []byte("benchmark-password")
+	benchmarkSalt     = []byte("benchmark-salt-benchmark-salt!!")
+)
+
+// BenchmarkKDF times a single Argon2id derivation with the given params, so
+// a settings screen can show e.g. "unlock will take ~1.3s with these
+// settings." It runs one untimed warm-up pass before the measured one.
+func BenchmarkKDF(params KDFParams) time.Duration {
+	derive := func() {
+		argon2.IDKey(benchmarkPassword, benchmarkSalt, params.Iterations, params.Memory, params.Parallelism, Argon2KeyLen)
+	}
+
+	derive()
+
+	start := time.Now()
+	derive()
+	return time.Since(start)
+}
@@ -0,0 +1,17 @@
+package crypto
+
+import "testing"
+
+func TestBenchmarkKDFNonDecreasingWithMoreWork(t *testing.T) {
+	small := KDFParams{Memory: 8 * 1024, Iterations: 1, Parallelism: 1}
+	large := KDFParams{Memory: 64 * 1024, Iterations: 3, Parallelism: 1}
+
+	smallDuration := BenchmarkKDF(small)
+	largeDuration := BenchmarkKDF(large)
+
+	// Allow generous tolerance: timing can be noisy under load, but
+	// substantially more work should not be faster.
+	if largeDuration < smallDuration/2 {
+		t.Errorf("Expected larger params to take at least as long, got small=%v large=%v", smallDuration, largeDuration)
+	}
+}
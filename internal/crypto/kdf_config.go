@@ -15,3 +15,16 @@ type KDFConfig struct {
 	Algorithm string    `json:"algorithm"`
 	Params    KDFParams `json:"params"`
 }
+
+// CurrentKDFConfig returns the KDF configuration matching the constants
+// currently used by DeriveKey/DeriveKeyWithKeyring.
+func CurrentKDFConfig() *KDFConfig {
+	return &KDFConfig{
+		Algorithm: "argon2id",
+		Params: KDFParams{
+			Memory:      Argon2Memory,
+			Iterations:  Argon2Time,
+			Parallelism: Argon2Threads,
+		},
+	}
+}
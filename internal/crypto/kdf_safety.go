@@ -0,0 +1,39 @@
+package crypto
+
+import "errors"
+
+// ErrWeakenedKDF is a non-fatal signal that SafeKDFParams had to reduce the
+// Argon2 memory cost below the normal Argon2Memory default to fit the
+// system's available RAM. The vault is still created; the caller should
+// advise the user to compensate with a longer passphrase.
+var ErrWeakenedKDF = errors.New("KDF memory cost reduced for low-memory system; use a stronger passphrase")
+
+// MinSafeArgon2MemoryKB is the lowest Argon2 memory cost SafeKDFParams will
+// return, even on a severely memory-constrained system.
+const MinSafeArgon2MemoryKB = 64 * 1024 // 64 MB
+
+// SafeKDFParams returns Argon2id parameters sized to fit a system with
+// availableMemoryKB of usable RAM, capping memory at a quarter of that
+// budget (so key derivation doesn't itself exhaust memory) and never below
+// MinSafeArgon2MemoryKB. weakened is true when the returned memory is lower
+// than the normal Argon2Memory default, in which case callers should
+// surface ErrWeakenedKDF to the user.
+func SafeKDFParams(availableMemoryKB uint64) (params KDFParams, weakened bool) {
+	memory := uint32(Argon2Memory)
+
+	budget := availableMemoryKB / 4
+	if budget < uint64(memory) {
+		if budget < MinSafeArgon2MemoryKB {
+			memory = MinSafeArgon2MemoryKB
+		} else {
+			memory = uint32(budget)
+		}
+		weakened = true
+	}
+
+	return KDFParams{
+		Memory:      memory,
+		Iterations:  Argon2Time,
+		Parallelism: Argon2Threads,
+	}, weakened
+}
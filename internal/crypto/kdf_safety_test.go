@@ -0,0 +1,36 @@
+package crypto
+
+import "testing"
+
+func TestSafeKDFParamsNormalMemoryIsUnweakened(t *testing.T) {
+	params, weakened := SafeKDFParams(8 * 1024 * 1024) // 8 GB
+	if weakened {
+		t.Fatal("Expected a normal-memory system to not be weakened")
+	}
+	if params.Memory != Argon2Memory {
+		t.Fatalf("Expected default Argon2Memory %d, got %d", Argon2Memory, params.Memory)
+	}
+}
+
+func TestSafeKDFParamsLowMemoryIsWeakened(t *testing.T) {
+	params, weakened := SafeKDFParams(128 * 1024) // 128 MB
+	if !weakened {
+		t.Fatal("Expected a low-memory system to be weakened")
+	}
+	if params.Memory >= Argon2Memory {
+		t.Fatalf("Expected reduced memory below %d, got %d", Argon2Memory, params.Memory)
+	}
+	if params.Memory < MinSafeArgon2MemoryKB {
+		t.Fatalf("Expected memory to never drop below %d, got %d", MinSafeArgon2MemoryKB, params.Memory)
+	}
+}
+
+func TestSafeKDFParamsSeverelyConstrainedClampsToFloor(t *testing.T) {
+	params, weakened := SafeKDFParams(32 * 1024) // 32 MB
+	if !weakened {
+		t.Fatal("Expected a severely constrained system to be weakened")
+	}
+	if params.Memory != MinSafeArgon2MemoryKB {
+		t.Fatalf("Expected memory clamped to floor %d, got %d", MinSafeArgon2MemoryKB, params.Memory)
+	}
+}
@@ -68,10 +68,20 @@ var ErrKeyringNotAvailable = errors.New("OS keyring not available")
 // ErrKeyringSecretNotFound is returned when no keyring secret exists.
 var ErrKeyringSecretNotFound = errors.New("keyring secret not found")
 
-// GetKeyringSecret retrieves the vault secret from the OS keyring.
+// GetKeyringSecret retrieves the vault secret from the OS keyring, retrying
+// with backoff (see withKeyringRetry) if the keyring is transiently
+// unavailable.
 func GetKeyringSecret() ([]byte, error) {
 	provider := getKeyringProvider()
-	secret, err := provider.Get(KeyringService, KeyringAccount)
+	var secret string
+	err := withKeyringRetry(func() error {
+		s, err := provider.Get(KeyringService, KeyringAccount)
+		if err != nil {
+			return err
+		}
+		secret = s
+		return nil
+	})
 	if err != nil {
 		if errors.Is(err, keyring.ErrNotFound) || errors.Is(err, ErrKeyringSecretNotFound) {
 			return nil, ErrKeyringSecretNotFound
@@ -88,7 +98,9 @@ func GetKeyringSecret() ([]byte, error) {
 	return decoded, nil
 }
 
-// CreateKeyringSecret generates and stores a new random secret in the OS keyring.
+// CreateKeyringSecret generates and stores a new random secret in the OS
+// keyring, retrying with backoff (see withKeyringRetry) if the keyring is
+// transiently unavailable.
 func CreateKeyringSecret() ([]byte, error) {
 	// Generate random secret
 	secret := make([]byte, KeyringSecretSize)
@@ -100,13 +112,30 @@ func CreateKeyringSecret() ([]byte, error) {
 	encoded := base64.StdEncoding.EncodeToString(secret)
 
 	provider := getKeyringProvider()
-	if err := provider.Set(KeyringService, KeyringAccount, encoded); err != nil {
+	err := withKeyringRetry(func() error {
+		return provider.Set(KeyringService, KeyringAccount, encoded)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return secret, nil
 }
 
+// StoreKeyringSecret writes secret into the OS keyring as-is, overwriting
+// any existing entry, retrying with backoff (see withKeyringRetry) if the
+// keyring is transiently unavailable. Used to restore a secret recovered via
+// MEKBundle.RecoverKeyringSecret after a reinstall wiped the keyring, unlike
+// CreateKeyringSecret which always generates a fresh random one.
+func StoreKeyringSecret(secret []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(secret)
+
+	provider := getKeyringProvider()
+	return withKeyringRetry(func() error {
+		return provider.Set(KeyringService, KeyringAccount, encoded)
+	})
+}
+
 // GetOrCreateKeyringSecret retrieves the secret from keyring, or creates one if it doesn't exist.
 func GetOrCreateKeyringSecret() ([]byte, error) {
 	secret, err := GetKeyringSecret()
@@ -127,6 +156,40 @@ func GenerateKeyringFingerprint(secret []byte) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// keyringProbeAccount is a dedicated account name for KeyringAvailable's
+// set/get/delete probe, so it never touches the real vault-secret entry.
+const keyringProbeAccount = "availability-probe"
+
+// KeyringAvailable reports whether the OS keyring backing the current
+// KeyringProvider can actually store and retrieve a secret, by writing a
+// throwaway value to a dedicated probe account, reading it back, and
+// deleting it. It returns false (with the underlying error) if any step
+// fails.
+func KeyringAvailable() (bool, error) {
+	provider := getKeyringProvider()
+
+	probe := make([]byte, KeyringSecretSize)
+	if _, err := rand.Read(probe); err != nil {
+		return false, err
+	}
+	encoded := base64.StdEncoding.EncodeToString(probe)
+
+	if err := provider.Set(KeyringService, keyringProbeAccount, encoded); err != nil {
+		return false, err
+	}
+	defer func() { _ = provider.Delete(KeyringService, keyringProbeAccount) }()
+
+	got, err := provider.Get(KeyringService, keyringProbeAccount)
+	if err != nil {
+		return false, err
+	}
+	if got != encoded {
+		return false, errors.New("keyring probe returned a different value than was stored")
+	}
+
+	return true, nil
+}
+
 // GetKeyringFingerprint retrieves the fingerprint of the current keyring secret.
 func GetKeyringFingerprint() string {
 	secret, err := GetKeyringSecret()
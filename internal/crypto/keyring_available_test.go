@@ -0,0 +1,46 @@
+package crypto
+
+import "testing"
+
+func TestKeyringAvailableWithMock(t *testing.T) {
+	mock, cleanup := UseMockKeyring()
+	defer cleanup()
+
+	available, err := KeyringAvailable()
+	if err != nil {
+		t.Fatalf("KeyringAvailable failed: %v", err)
+	}
+	if !available {
+		t.Error("Expected keyring to be available with a working mock")
+	}
+	if mock.HasSecret(KeyringService, keyringProbeAccount) {
+		t.Error("Expected probe secret to be cleaned up")
+	}
+}
+
+type brokenKeyring struct{}
+
+func (brokenKeyring) Get(service, account string) (string, error) {
+	return "", ErrKeyringNotAvailable
+}
+
+func (brokenKeyring) Set(service, account, password string) error {
+	return ErrKeyringNotAvailable
+}
+
+func (brokenKeyring) Delete(service, account string) error {
+	return ErrKeyringNotAvailable
+}
+
+func TestKeyringAvailableWithBrokenProvider(t *testing.T) {
+	SetKeyringProvider(brokenKeyring{})
+	defer SetKeyringProvider(&osKeyring{})
+
+	available, err := KeyringAvailable()
+	if err == nil {
+		t.Fatal("Expected an error from a broken keyring provider")
+	}
+	if available {
+		t.Error("Expected KeyringAvailable to report false on error")
+	}
+}
@@ -0,0 +1,53 @@
+package crypto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keyringRetryAttempts is how many retries a keyring operation gets after
+// its first attempt, e.g. for a GNOME Keyring that's briefly unavailable
+// right after login.
+const keyringRetryAttempts = 3
+
+// keyringRetryBaseDelay is the delay before the first retry; each
+// subsequent retry doubles it.
+const keyringRetryBaseDelay = 100 * time.Millisecond
+
+// keyringSleep is overridable in tests so retry-with-backoff tests don't
+// actually wait.
+var keyringSleep = time.Sleep
+
+// SetKeyringSleep overrides the sleep function used between keyring
+// retries. Pass nil to restore time.Sleep.
+func SetKeyringSleep(fn func(time.Duration)) {
+	if fn == nil {
+		fn = time.Sleep
+	}
+	keyringSleep = fn
+}
+
+// withKeyringRetry retries op with exponential backoff on failure, up to
+// keyringRetryAttempts retries. A "not found" error is returned immediately
+// without retrying, since it's a permanent state rather than the transient
+// unavailability this exists to ride out.
+func withKeyringRetry(op func() error) error {
+	var err error
+	delay := keyringRetryBaseDelay
+	for attempt := 0; attempt <= keyringRetryAttempts; attempt++ {
+		if err = op(); err == nil {
+			return nil
+		}
+		if errors.Is(err, keyring.ErrNotFound) {
+			return err
+		}
+		if attempt == keyringRetryAttempts {
+			break
+		}
+		keyringSleep(delay)
+		delay *= 2
+	}
+	return err
+}
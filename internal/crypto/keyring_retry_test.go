@@ -0,0 +1,96 @@
+package crypto
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// flakyKeyring wraps a KeyringProvider and fails the first N calls to each
+// method with errFlaky before delegating to the real provider.
+type flakyKeyring struct {
+	inner      KeyringProvider
+	failsLeft  int
+	gets, sets int
+}
+
+var errFlaky = errors.New("keyring temporarily unavailable")
+
+func (f *flakyKeyring) Get(service, account string) (string, error) {
+	f.gets++
+	if f.failsLeft > 0 {
+		f.failsLeft--
+		return "", errFlaky
+	}
+	return f.inner.Get(service, account)
+}
+
+func (f *flakyKeyring) Set(service, account, password string) error {
+	f.sets++
+	if f.failsLeft > 0 {
+		f.failsLeft--
+		return errFlaky
+	}
+	return f.inner.Set(service, account, password)
+}
+
+func (f *flakyKeyring) Delete(service, account string) error {
+	return f.inner.Delete(service, account)
+}
+
+func TestGetKeyringSecretRetriesOnTransientFailure(t *testing.T) {
+	defer func() { SetKeyringProvider(&osKeyring{}) }()
+	SetKeyringSleep(func(time.Duration) {})
+	defer SetKeyringSleep(nil)
+
+	mock := NewMockKeyring()
+	SetKeyringProvider(mock)
+	secret, err := CreateKeyringSecret()
+	if err != nil {
+		t.Fatalf("failed to seed secret: %v", err)
+	}
+
+	flaky := &flakyKeyring{inner: mock, failsLeft: 1}
+	SetKeyringProvider(flaky)
+
+	got, err := GetKeyringSecret()
+	if err != nil {
+		t.Fatalf("Expected eventual success, got %v", err)
+	}
+	if string(got) != string(secret) {
+		t.Fatalf("Expected retrieved secret to match seeded secret")
+	}
+	if flaky.gets < 2 {
+		t.Fatalf("Expected at least 2 Get attempts, got %d", flaky.gets)
+	}
+}
+
+func TestCreateKeyringSecretRetriesOnTransientFailure(t *testing.T) {
+	defer func() { SetKeyringProvider(&osKeyring{}) }()
+	SetKeyringSleep(func(time.Duration) {})
+	defer SetKeyringSleep(nil)
+
+	mock := NewMockKeyring()
+	flaky := &flakyKeyring{inner: mock, failsLeft: 2}
+	SetKeyringProvider(flaky)
+
+	if _, err := CreateKeyringSecret(); err != nil {
+		t.Fatalf("Expected eventual success, got %v", err)
+	}
+	if flaky.sets < 3 {
+		t.Fatalf("Expected at least 3 Set attempts, got %d", flaky.sets)
+	}
+}
+
+func TestGetKeyringSecretDoesNotRetryNotFound(t *testing.T) {
+	defer func() { SetKeyringProvider(&osKeyring{}) }()
+	SetKeyringSleep(func(time.Duration) {})
+	defer SetKeyringSleep(nil)
+
+	mock := NewMockKeyring()
+	SetKeyringProvider(mock)
+
+	if _, err := GetKeyringSecret(); !errors.Is(err, ErrKeyringSecretNotFound) {
+		t.Fatalf("Expected ErrKeyringSecretNotFound, got %v", err)
+	}
+}
@@ -4,16 +4,28 @@ package crypto
 import (
 	"errors"
 	"fmt"
+
+	"github.com/batterdaysahead/cipher0/internal/config"
 )
 
 var (
 	// ErrMEKDecryptionFailed is returned when MEK decryption fails.
 	ErrMEKDecryptionFailed = errors.New("failed to decrypt master encryption key: wrong password or phrase")
+	// ErrPasswordTooShort is returned when a new master password is shorter
+	// than config.MinPasswordLength.
+	ErrPasswordTooShort = errors.New("new password is too short")
 )
 
 // MEKSize is the size of the Master Encryption Key (32 bytes for AES-256).
 const MEKSize = 32
 
+// encryptedMEKLength is the fixed byte length of an Encrypt()ed MEK: a
+// NonceSize-byte nonce, the MEKSize-byte plaintext, and a 16-byte GCM tag.
+// Used to generate indistinguishable random filler for an unconfigured
+// duress slot (see fillDuressSlot) that's the exact same shape as a real
+// one.
+const encryptedMEKLength = NonceSize + MEKSize + 16
+
 // GenerateMEK generates a new random Master Encryption Key.
 // The MEK is used to encrypt all vault data.
 func GenerateMEK() ([]byte, error) {
@@ -51,12 +63,54 @@ type MEKBundle struct {
 	EncryptedMEKPassword []byte
 	// EncryptedMEKPhrase is the MEK encrypted with the phrase-derived key.
 	EncryptedMEKPhrase []byte
+	// EncryptedKeyringSecret, when set (see CreateMEKBundleWithKeyringBackup),
+	// holds the keyring secret encrypted with the phrase-derived key, so
+	// RecoverKeyringSecret can restore it after a reinstall wipes the OS
+	// keyring, instead of leaving the recovery phrase as the only way back
+	// in. Nil for bundles created without that option.
+	EncryptedKeyringSecret []byte
+	// SaltDuress and EncryptedMEKDuress wrap a second, independent MEK under
+	// a duress password for plausible deniability: entering the duress
+	// password instead of the real one unlocks a decoy vault built on that
+	// MEK (see CreateDuressBundle, MEKBundle.SetDuressPassword, and
+	// UnlockEither). Every bundle has these fields populated, whether or not
+	// a duress password is actually configured -- an unconfigured bundle
+	// gets indistinguishable random filler of the same length instead of
+	// leaving the fields empty, so the bundle's shape never reveals whether
+	// a real duress password exists underneath.
+	SaltDuress         []byte
+	EncryptedMEKDuress []byte
+	// KDF records the key derivation parameters used to protect this bundle,
+	// so audits can later confirm what actually protects the vault.
+	KDF *KDFConfig
+}
+
+// KDFInfo returns the KDF configuration protecting this bundle, falling back
+// to the current configuration for bundles created before this field existed.
+func (b *MEKBundle) KDFInfo() KDFConfig {
+	if b.KDF == nil {
+		return *CurrentKDFConfig()
+	}
+	return *b.KDF
 }
 
 // CreateMEKBundle creates a new MEK bundle with the MEK encrypted using both
 // the master password (combined with keyring secret) and the recovery phrase.
 // Returns the bundle and the recovery phrase (which must be shown to the user).
 func CreateMEKBundle(password string) (*MEKBundle, string, error) {
+	return createMEKBundle(password, false, "")
+}
+
+// CreateMEKBundleWithKeyringBackup is CreateMEKBundle plus an encrypted copy
+// of the keyring secret in the bundle (see MEKBundle.EncryptedKeyringSecret),
+// so a reinstall that wipes the OS keyring doesn't force a full backup
+// restore just to keep unlocking with the master password: RecoverKeyringSecret
+// puts the same secret back.
+func CreateMEKBundleWithKeyringBackup(password string) (*MEKBundle, string, error) {
+	return createMEKBundle(password, true, "")
+}
+
+func createMEKBundle(password string, backupKeyring bool, duressPassword string) (*MEKBundle, string, error) {
 	mek, err := GenerateMEK()
 	if err != nil {
 		return nil, "", err
@@ -108,11 +162,49 @@ func CreateMEKBundle(password string) (*MEKBundle, string, error) {
 		SaltPhrase:           saltPhrase,
 		EncryptedMEKPassword: encryptedMEKPassword,
 		EncryptedMEKPhrase:   encryptedMEKPhrase,
+		KDF:                  CurrentKDFConfig(),
+	}
+
+	if backupKeyring {
+		encryptedKeyringSecret, err := Encrypt(keyringSecret, phraseKey)
+		if err != nil {
+			return nil, "", err
+		}
+		bundle.EncryptedKeyringSecret = encryptedKeyringSecret
+	}
+
+	if err := fillDuressSlot(bundle, duressPassword); err != nil {
+		return nil, "", err
 	}
 
 	return bundle, phrase, nil
 }
 
+// RecoverKeyringSecret decrypts the bundle's backed-up keyring secret using
+// the recovery phrase. Returns ErrKeyringSecretNotFound if the bundle wasn't
+// created with CreateMEKBundleWithKeyringBackup, or ErrMEKDecryptionFailed if
+// phrase is wrong.
+func (b *MEKBundle) RecoverKeyringSecret(phrase string) ([]byte, error) {
+	if len(b.EncryptedKeyringSecret) == 0 {
+		return nil, ErrKeyringSecretNotFound
+	}
+
+	phraseKey, err := PhraseToKey(phrase)
+	if err != nil {
+		return nil, err
+	}
+	defer ZeroMemory(phraseKey)
+
+	secret, err := Decrypt(b.EncryptedKeyringSecret, phraseKey)
+	if err != nil {
+		if errors.Is(err, ErrDecryptionFailed) {
+			return nil, ErrMEKDecryptionFailed
+		}
+		return nil, err
+	}
+	return secret, nil
+}
+
 // DecryptMEKWithPassword decrypts the MEK using the master password combined with keyring secret.
 func (b *MEKBundle) DecryptMEKWithPassword(password string) ([]byte, error) {
 	keyringSecret, err := GetKeyringSecret()
@@ -174,3 +266,23 @@ func (b *MEKBundle) ReEncryptMEKWithNewPassword(mek []byte, newPassword string)
 
 	return nil
 }
+
+// ChangePassword verifies oldPassword against the bundle before rewrapping
+// the MEK under newPassword, so a caller can't accidentally lock themselves
+// out by rewrapping with a password they didn't actually mean to set.
+// Returns ErrMEKDecryptionFailed if oldPassword is wrong, or
+// ErrPasswordTooShort if newPassword is shorter than
+// config.MinPasswordLength. The bundle is left unchanged on either error.
+func (b *MEKBundle) ChangePassword(oldPassword, newPassword string) error {
+	if len(newPassword) < config.MinPasswordLength {
+		return ErrPasswordTooShort
+	}
+
+	mek, err := b.DecryptMEKWithPassword(oldPassword)
+	if err != nil {
+		return err
+	}
+	defer ZeroMemory(mek)
+
+	return b.ReEncryptMEKWithNewPassword(mek, newPassword)
+}
@@ -0,0 +1,72 @@
+package crypto
+
+import "testing"
+
+func TestRecoverKeyringSecretAfterLosingKeyring(t *testing.T) {
+	mock, cleanup := UseMockKeyring()
+	defer cleanup()
+
+	const password = "correct horse battery staple"
+
+	bundle, phrase, err := CreateMEKBundleWithKeyringBackup(password)
+	if err != nil {
+		t.Fatalf("CreateMEKBundleWithKeyringBackup failed: %v", err)
+	}
+
+	// Simulate a reinstall that wipes the OS keyring.
+	mock.Reset()
+
+	if _, err := bundle.DecryptMEKWithPassword(password); err == nil {
+		t.Fatal("Expected password unlock to fail once the keyring is gone")
+	}
+
+	secret, err := bundle.RecoverKeyringSecret(phrase)
+	if err != nil {
+		t.Fatalf("RecoverKeyringSecret failed: %v", err)
+	}
+
+	if err := StoreKeyringSecret(secret); err != nil {
+		t.Fatalf("StoreKeyringSecret failed: %v", err)
+	}
+
+	mek, err := bundle.DecryptMEKWithPassword(password)
+	if err != nil {
+		t.Fatalf("Expected password unlock to succeed after recovery, got: %v", err)
+	}
+	if len(mek) != MEKSize {
+		t.Errorf("Expected MEK size %d, got %d", MEKSize, len(mek))
+	}
+}
+
+func TestRecoverKeyringSecretWithoutBackupOption(t *testing.T) {
+	_, cleanup := UseMockKeyring()
+	defer cleanup()
+
+	bundle, phrase, err := CreateMEKBundle("password")
+	if err != nil {
+		t.Fatalf("CreateMEKBundle failed: %v", err)
+	}
+
+	if _, err := bundle.RecoverKeyringSecret(phrase); err != ErrKeyringSecretNotFound {
+		t.Fatalf("Expected ErrKeyringSecretNotFound, got: %v", err)
+	}
+}
+
+func TestRecoverKeyringSecretWrongPhrase(t *testing.T) {
+	_, cleanup := UseMockKeyring()
+	defer cleanup()
+
+	bundle, _, err := CreateMEKBundleWithKeyringBackup("password")
+	if err != nil {
+		t.Fatalf("CreateMEKBundleWithKeyringBackup failed: %v", err)
+	}
+
+	wrongPhrase, err := GenerateRecoveryPhrase()
+	if err != nil {
+		t.Fatalf("GenerateRecoveryPhrase failed: %v", err)
+	}
+
+	if _, err := bundle.RecoverKeyringSecret(wrongPhrase); err != ErrMEKDecryptionFailed {
+		t.Fatalf("Expected ErrMEKDecryptionFailed, got: %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package crypto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// mekBundleFormatVersion is the version of the MEKBundle JSON envelope.
+// Bump it whenever the encoded structure changes in an incompatible way.
+const mekBundleFormatVersion = 1
+
+// ErrUnsupportedMEKBundleVersion is returned when decoding a MEKBundle
+// envelope with a format version this build doesn't understand.
+var ErrUnsupportedMEKBundleVersion = errors.New("unsupported MEK bundle format version")
+
+// mekBundleEnvelope is the explicit, versioned on-disk JSON structure for a
+// MEKBundle. Byte slices are base64-encoded strings rather than JSON's
+// default byte-array-as-base64-string behavior left implicit, so the format
+// is documented and stable independent of MEKBundle's Go field types.
+type mekBundleEnvelope struct {
+	Version                int    `json:"version"`
+	SaltPassword           string `json:"salt_password"`
+	SaltPhrase             string `json:"salt_phrase"`
+	EncryptedMEKPassword   string `json:"encrypted_mek_password"`
+	EncryptedMEKPhrase     string `json:"encrypted_mek_phrase"`
+	EncryptedKeyringSecret string `json:"encrypted_keyring_secret,omitempty"`
+	// SaltDuress/EncryptedMEKDuress are deliberately NOT omitempty: every
+	// bundle has them populated (real or indistinguishable random filler,
+	// see MEKBundle.SaltDuress), and omitting them when empty would let
+	// anyone reading the file tell a configured duress password from an
+	// unconfigured one just from the JSON shape.
+	SaltDuress         string     `json:"salt_duress"`
+	EncryptedMEKDuress string     `json:"encrypted_mek_duress"`
+	KDF                *KDFConfig `json:"kdf,omitempty"`
+}
+
+// MarshalJSON encodes the bundle as a versioned envelope with base64 fields.
+func (b *MEKBundle) MarshalJSON() ([]byte, error) {
+	env := mekBundleEnvelope{
+		Version:              mekBundleFormatVersion,
+		SaltPassword:         base64.StdEncoding.EncodeToString(b.SaltPassword),
+		SaltPhrase:           base64.StdEncoding.EncodeToString(b.SaltPhrase),
+		EncryptedMEKPassword: base64.StdEncoding.EncodeToString(b.EncryptedMEKPassword),
+		EncryptedMEKPhrase:   base64.StdEncoding.EncodeToString(b.EncryptedMEKPhrase),
+		SaltDuress:           base64.StdEncoding.EncodeToString(b.SaltDuress),
+		EncryptedMEKDuress:   base64.StdEncoding.EncodeToString(b.EncryptedMEKDuress),
+		KDF:                  b.KDF,
+	}
+	if len(b.EncryptedKeyringSecret) > 0 {
+		env.EncryptedKeyringSecret = base64.StdEncoding.EncodeToString(b.EncryptedKeyringSecret)
+	}
+	return json.Marshal(env)
+}
+
+// UnmarshalJSON decodes a versioned envelope produced by MarshalJSON.
+func (b *MEKBundle) UnmarshalJSON(data []byte) error {
+	var env mekBundleEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return err
+	}
+
+	if env.Version != mekBundleFormatVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedMEKBundleVersion, env.Version)
+	}
+
+	saltPassword, err := base64.StdEncoding.DecodeString(env.SaltPassword)
+	if err != nil {
+		return err
+	}
+	saltPhrase, err := base64.StdEncoding.DecodeString(env.SaltPhrase)
+	if err != nil {
+		return err
+	}
+	encMEKPassword, err := base64.StdEncoding.DecodeString(env.EncryptedMEKPassword)
+	if err != nil {
+		return err
+	}
+	encMEKPhrase, err := base64.StdEncoding.DecodeString(env.EncryptedMEKPhrase)
+	if err != nil {
+		return err
+	}
+
+	var encKeyringSecret []byte
+	if env.EncryptedKeyringSecret != "" {
+		encKeyringSecret, err = base64.StdEncoding.DecodeString(env.EncryptedKeyringSecret)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Pre-duress bundles have no salt_duress/encrypted_mek_duress fields at
+	// all; decode whatever is there (empty string decodes to a nil slice).
+	saltDuress, err := base64.StdEncoding.DecodeString(env.SaltDuress)
+	if err != nil {
+		return err
+	}
+	encMEKDuress, err := base64.StdEncoding.DecodeString(env.EncryptedMEKDuress)
+	if err != nil {
+		return err
+	}
+
+	b.SaltPassword = saltPassword
+	b.SaltPhrase = saltPhrase
+	b.EncryptedMEKPassword = encMEKPassword
+	b.EncryptedMEKPhrase = encMEKPhrase
+	b.EncryptedKeyringSecret = encKeyringSecret
+	b.SaltDuress = saltDuress
+	b.EncryptedMEKDuress = encMEKDuress
+	b.KDF = env.KDF
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package crypto
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestMEKBundleJSONRoundTrip(t *testing.T) {
+	bundle, _, err := CreateMEKBundle("password")
+	if err != nil {
+		t.Fatalf("CreateMEKBundle failed: %v", err)
+	}
+
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var decoded MEKBundle
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if string(decoded.SaltPassword) != string(bundle.SaltPassword) {
+		t.Error("SaltPassword did not round-trip")
+	}
+	if string(decoded.SaltPhrase) != string(bundle.SaltPhrase) {
+		t.Error("SaltPhrase did not round-trip")
+	}
+	if string(decoded.EncryptedMEKPassword) != string(bundle.EncryptedMEKPassword) {
+		t.Error("EncryptedMEKPassword did not round-trip")
+	}
+	if string(decoded.EncryptedMEKPhrase) != string(bundle.EncryptedMEKPhrase) {
+		t.Error("EncryptedMEKPhrase did not round-trip")
+	}
+	if decoded.KDF == nil || decoded.KDF.Algorithm != bundle.KDF.Algorithm {
+		t.Error("KDF did not round-trip")
+	}
+}
+
+func TestMEKBundleUnmarshalRejectsUnknownVersion(t *testing.T) {
+	data := `{"version": 999, "salt_password": "", "salt_phrase": "", "encrypted_mek_password": "", "encrypted_mek_phrase": ""}`
+
+	var decoded MEKBundle
+	err := json.Unmarshal([]byte(data), &decoded)
+	if err == nil {
+		t.Fatal("Expected an error for an unknown format version")
+	}
+	if !strings.Contains(err.Error(), "unsupported MEK bundle format version") {
+		t.Errorf("Expected unsupported version error, got: %v", err)
+	}
+}
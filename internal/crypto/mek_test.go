@@ -154,6 +154,35 @@ func TestMEKBundleBothMethodsProduceSameMEK(t *testing.T) {
 	}
 }
 
+func TestMEKBundleKDFInfo(t *testing.T) {
+	bundle, _, err := CreateMEKBundle("test-password-123")
+	if err != nil {
+		t.Fatalf("CreateMEKBundle failed: %v", err)
+	}
+
+	info := bundle.KDFInfo()
+	if info.Algorithm != "argon2id" {
+		t.Errorf("Expected algorithm argon2id, got %s", info.Algorithm)
+	}
+	if info.Params.Memory != Argon2Memory {
+		t.Errorf("Expected memory %d, got %d", Argon2Memory, info.Params.Memory)
+	}
+	if info.Params.Iterations != Argon2Time {
+		t.Errorf("Expected iterations %d, got %d", Argon2Time, info.Params.Iterations)
+	}
+	if info.Params.Parallelism != Argon2Threads {
+		t.Errorf("Expected parallelism %d, got %d", Argon2Threads, info.Params.Parallelism)
+	}
+}
+
+func TestMEKBundleKDFInfoFallback(t *testing.T) {
+	bundle := &MEKBundle{}
+	info := bundle.KDFInfo()
+	if info.Algorithm != "argon2id" {
+		t.Errorf("Expected fallback algorithm argon2id, got %s", info.Algorithm)
+	}
+}
+
 func TestReEncryptMEKWithNewPassword(t *testing.T) {
 	oldPassword := "old-password"
 	newPassword := "new-password"
@@ -199,3 +228,61 @@ func TestReEncryptMEKWithNewPassword(t *testing.T) {
 		t.Error("Recovery phrase should still decrypt to the same MEK")
 	}
 }
+
+func TestChangePasswordWrongOldPasswordLeavesBundleUnchanged(t *testing.T) {
+	bundle, _, err := CreateMEKBundle("old-password")
+	if err != nil {
+		t.Fatalf("CreateMEKBundle failed: %v", err)
+	}
+
+	if err := bundle.ChangePassword("wrong-password", "new-password-123"); err == nil {
+		t.Error("Expected ChangePassword to fail with the wrong old password")
+	}
+
+	if _, err := bundle.DecryptMEKWithPassword("old-password"); err != nil {
+		t.Errorf("Old password should still work after a failed change: %v", err)
+	}
+}
+
+func TestChangePasswordRejectsShortNewPassword(t *testing.T) {
+	bundle, _, err := CreateMEKBundle("old-password")
+	if err != nil {
+		t.Fatalf("CreateMEKBundle failed: %v", err)
+	}
+
+	if err := bundle.ChangePassword("old-password", "short"); err != ErrPasswordTooShort {
+		t.Errorf("Expected ErrPasswordTooShort, got %v", err)
+	}
+
+	if _, err := bundle.DecryptMEKWithPassword("old-password"); err != nil {
+		t.Errorf("Old password should still work after a rejected change: %v", err)
+	}
+}
+
+func TestChangePasswordSucceeds(t *testing.T) {
+	bundle, phrase, err := CreateMEKBundle("old-password")
+	if err != nil {
+		t.Fatalf("CreateMEKBundle failed: %v", err)
+	}
+	originalMEK, _ := bundle.DecryptMEKWithPassword("old-password")
+
+	if err := bundle.ChangePassword("old-password", "new-password-123"); err != nil {
+		t.Fatalf("ChangePassword failed: %v", err)
+	}
+
+	if _, err := bundle.DecryptMEKWithPassword("old-password"); err == nil {
+		t.Error("Old password should no longer work")
+	}
+
+	newMEK, err := bundle.DecryptMEKWithPassword("new-password-123")
+	if err != nil {
+		t.Fatalf("New password should work: %v", err)
+	}
+	if string(originalMEK) != string(newMEK) {
+		t.Error("MEK should remain the same after password change")
+	}
+
+	if _, err := bundle.DecryptMEKWithPhrase(phrase); err != nil {
+		t.Errorf("Recovery phrase should still work: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package crypto
+
+import (
+	"github.com/luxfi/go-bip39"
+	"github.com/luxfi/go-bip39/wordlists"
+)
+
+// languageWordlists lists the BIP39 wordlists checked by DetectPhraseLanguage,
+// in order of preference. English is checked first since it's the default
+// language used by GenerateRecoveryPhrase.
+var languageWordlists = []struct {
+	name string
+	list []string
+}{
+	{"english", wordlists.English},
+	{"spanish", wordlists.Spanish},
+	{"french", wordlists.French},
+	{"italian", wordlists.Italian},
+	{"czech", wordlists.Czech},
+	{"japanese", wordlists.Japanese},
+	{"korean", wordlists.Korean},
+	{"chinese_simplified", wordlists.ChineseSimplified},
+	{"chinese_traditional", wordlists.ChineseTraditional},
+}
+
+// DetectPhraseLanguage reports which BIP39 wordlist every word of phrase
+// belongs to. It only checks word membership, not the mnemonic checksum, so
+// it works for recovery phrases in any supported language. ok is false when
+// no single wordlist covers every word.
+func DetectPhraseLanguage(phrase string) (language string, ok bool) {
+	words := ParsePhraseWords(phrase)
+	if len(words) == 0 {
+		return "", false
+	}
+
+	for _, wl := range languageWordlists {
+		if allWordsIn(words, wl.list) {
+			return wl.name, true
+		}
+	}
+
+	return "", false
+}
+
+// allWordsIn reports whether every word in words appears in list.
+func allWordsIn(words, list []string) bool {
+	set := make(map[string]struct{}, len(list))
+	for _, w := range list {
+		set[w] = struct{}{}
+	}
+
+	for _, w := range words {
+		if _, found := set[w]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// withDetectedWordList runs fn with the BIP39 word list temporarily swapped
+// to the wordlist detected for phrase, falling back to the current (English
+// by default) word list when detection fails. The word list is restored
+// afterward.
+func withDetectedWordList(phrase string, fn func()) {
+	language, ok := DetectPhraseLanguage(phrase)
+	if !ok {
+		fn()
+		return
+	}
+
+	var list []string
+	for _, wl := range languageWordlists {
+		if wl.name == language {
+			list = wl.list
+			break
+		}
+	}
+	if list == nil {
+		fn()
+		return
+	}
+
+	previous := bip39.GetWordList()
+	bip39.SetWordList(list)
+	defer bip39.SetWordList(previous)
+
+	fn()
+}
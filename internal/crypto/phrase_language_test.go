@@ -0,0 +1,25 @@
+package crypto
+
+import "testing"
+
+func TestDetectPhraseLanguageEnglish(t *testing.T) {
+	phrase, err := GenerateRecoveryPhrase()
+	if err != nil {
+		t.Fatalf("GenerateRecoveryPhrase failed: %v", err)
+	}
+
+	language, ok := DetectPhraseLanguage(phrase)
+	if !ok {
+		t.Fatal("Expected language detection to succeed for a generated phrase")
+	}
+	if language != "english" {
+		t.Errorf("Expected english, got %q", language)
+	}
+}
+
+func TestDetectPhraseLanguageUnknown(t *testing.T) {
+	_, ok := DetectPhraseLanguage("not real bip39 words at all here today")
+	if ok {
+		t.Error("Expected detection to fail for a non-wordlist phrase")
+	}
+}
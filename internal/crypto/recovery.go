@@ -54,7 +54,11 @@ func NormalizePhrase(phrase string) string {
 func PhraseToKey(phrase string) ([]byte, error) {
 	phrase = NormalizePhrase(phrase)
 
-	if !ValidateRecoveryPhrase(phrase) {
+	valid := false
+	withDetectedWordList(phrase, func() {
+		valid = ValidateRecoveryPhrase(phrase)
+	})
+	if !valid {
 		return nil, ErrInvalidMnemonic
 	}
 
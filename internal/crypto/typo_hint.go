@@ -0,0 +1,43 @@
+package crypto
+
+import "strings"
+
+// SuggestTypoFixes returns candidate variants of a failed unlock password
+// worth suggesting to the user, most commonly because caps lock was on. It
+// never modifies or retries the password itself; callers must only display
+// the suggestions and let the user choose to retry.
+func SuggestTypoFixes(password string) []string {
+	var suggestions []string
+	seen := map[string]struct{}{password: {}}
+
+	add := func(candidate string) {
+		if candidate == "" {
+			return
+		}
+		if _, ok := seen[candidate]; ok {
+			return
+		}
+		seen[candidate] = struct{}{}
+		suggestions = append(suggestions, candidate)
+	}
+
+	add(invertCase(password))
+	add(strings.TrimSpace(password))
+	add(invertCase(strings.TrimSpace(password)))
+
+	return suggestions
+}
+
+// invertCase swaps the case of every letter in s.
+func invertCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z':
+			return r - 'a' + 'A'
+		case 'A' <= r && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return r
+		}
+	}, s)
+}
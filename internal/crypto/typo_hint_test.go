@@ -0,0 +1,40 @@
+package crypto
+
+import "testing"
+
+func TestSuggestTypoFixesAllCapsYieldsLowercase(t *testing.T) {
+	suggestions := SuggestTypoFixes("HUNTER2")
+
+	found := false
+	for _, s := range suggestions {
+		if s == "hunter2" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected lowercase variant in suggestions, got %v", suggestions)
+	}
+}
+
+func TestSuggestTypoFixesTrimsWhitespace(t *testing.T) {
+	suggestions := SuggestTypoFixes(" hunter2 ")
+
+	found := false
+	for _, s := range suggestions {
+		if s == "hunter2" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected trimmed variant in suggestions, got %v", suggestions)
+	}
+}
+
+func TestSuggestTypoFixesNoDuplicates(t *testing.T) {
+	suggestions := SuggestTypoFixes("hunter2")
+	for _, s := range suggestions {
+		if s == "hunter2" {
+			t.Errorf("Expected suggestions to exclude the original password, got %v", suggestions)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/batterdaysahead/cipher0/internal/config"
+)
+
+// maxUnlockBackoff caps the exponential backoff delay imposed between
+// consecutive failed unlock attempts.
+const maxUnlockBackoff = 30 * time.Second
+
+// unlockLimiterState is the on-disk representation persisted between runs.
+type unlockLimiterState struct {
+	Failures int `json:"failures"`
+}
+
+// UnlockLimiter slows down brute-force attempts against a stolen vault file
+// plus keyring by imposing an exponential backoff delay after consecutive
+// failed unlock attempts. The failure count is persisted to a small state
+// file so the backoff survives process restarts.
+type UnlockLimiter struct {
+	mu        sync.Mutex
+	statePath string
+	sleepFunc func(time.Duration)
+	failures  int
+}
+
+// NewUnlockLimiter creates a limiter backed by the given state file,
+// loading any previously persisted failure count.
+func NewUnlockLimiter(statePath string) *UnlockLimiter {
+	l := &UnlockLimiter{
+		statePath: statePath,
+		sleepFunc: time.Sleep,
+	}
+	l.failures = l.load()
+	return l
+}
+
+// SetSleepFunc overrides the function used to apply backoff delays. Used in
+// tests to avoid real sleeping.
+func (l *UnlockLimiter) SetSleepFunc(sleep func(time.Duration)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sleepFunc = sleep
+}
+
+// Wait blocks for the current backoff delay, based on the number of
+// consecutive failures recorded so far. It is a no-op after no failures.
+func (l *UnlockLimiter) Wait() {
+	l.mu.Lock()
+	delay := backoffDelay(l.failures)
+	sleep := l.sleepFunc
+	l.mu.Unlock()
+
+	if delay > 0 {
+		sleep(delay)
+	}
+}
+
+// RecordFailure increments the consecutive failure count and persists it.
+func (l *UnlockLimiter) RecordFailure() error {
+	l.mu.Lock()
+	l.failures++
+	failures := l.failures
+	l.mu.Unlock()
+
+	return l.save(failures)
+}
+
+// RecordSuccess resets the consecutive failure count.
+func (l *UnlockLimiter) RecordSuccess() error {
+	l.mu.Lock()
+	l.failures = 0
+	l.mu.Unlock()
+
+	return l.save(0)
+}
+
+// Failures returns the current consecutive failure count.
+func (l *UnlockLimiter) Failures() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.failures
+}
+
+// unlockLimiterDir is the subdirectory of the config directory that holds
+// per-vault unlock-limiter state files.
+const unlockLimiterDir = "unlock-limits"
+
+// UnlockLimiterFor returns an UnlockLimiter whose persisted state is keyed
+// by a hash of vaultPath, so separate vaults (or the same vault moved
+// between paths) never share a failure count. The state file lives under
+// the config directory rather than next to the vault, since a stolen vault
+// file shouldn't be able to carry its own backoff state along with it.
+func UnlockLimiterFor(vaultPath string) *UnlockLimiter {
+	sum := sha256.Sum256([]byte(vaultPath))
+	fileName := hex.EncodeToString(sum[:]) + ".json"
+	statePath := filepath.Join(config.ConfigDir(), unlockLimiterDir, fileName)
+	return NewUnlockLimiter(statePath)
+}
+
+// backoffDelay returns the backoff delay for a given number of consecutive
+// failures: 1s, 2s, 4s, 8s, ... capped at maxUnlockBackoff.
+func backoffDelay(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+
+	delay := time.Second
+	for i := 1; i < failures; i++ {
+		delay *= 2
+		if delay >= maxUnlockBackoff {
+			return maxUnlockBackoff
+		}
+	}
+	return delay
+}
+
+// load reads the persisted failure count, defaulting to 0 if the state file
+// doesn't exist or can't be parsed.
+func (l *UnlockLimiter) load() int {
+	data, err := os.ReadFile(l.statePath)
+	if err != nil {
+		return 0
+	}
+
+	var state unlockLimiterState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return 0
+	}
+
+	return state.Failures
+}
+
+// save persists the failure count to the state file, creating its parent
+// directory if needed.
+func (l *UnlockLimiter) save(failures int) error {
+	data, err := json.Marshal(unlockLimiterState{Failures: failures})
+	if err != nil {
+		return err
+	}
+
+	if dir := filepath.Dir(l.statePath); dir != "." {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(l.statePath, data, 0600)
+}
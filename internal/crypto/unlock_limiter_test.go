@@ -0,0 +1,97 @@
+package crypto
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestUnlockLimiterBackoffGrowsWithFailures(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "unlock-limiter.json")
+	limiter := NewUnlockLimiter(statePath)
+
+	var slept []time.Duration
+	limiter.SetSleepFunc(func(d time.Duration) { slept = append(slept, d) })
+
+	for i := 0; i < 4; i++ {
+		limiter.Wait()
+		if err := limiter.RecordFailure(); err != nil {
+			t.Fatalf("RecordFailure failed: %v", err)
+		}
+	}
+	limiter.Wait()
+
+	if len(slept) != 4 {
+		t.Fatalf("Expected 4 backoff sleeps, got %d", len(slept))
+	}
+	for i := 1; i < len(slept); i++ {
+		if slept[i] < slept[i-1] {
+			t.Errorf("Expected non-decreasing backoff, got %v then %v", slept[i-1], slept[i])
+		}
+	}
+}
+
+func TestUnlockLimiterResetsOnSuccess(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "unlock-limiter.json")
+	limiter := NewUnlockLimiter(statePath)
+
+	limiter.RecordFailure()
+	limiter.RecordFailure()
+	limiter.RecordFailure()
+
+	if limiter.Failures() != 3 {
+		t.Fatalf("Expected 3 failures, got %d", limiter.Failures())
+	}
+
+	if err := limiter.RecordSuccess(); err != nil {
+		t.Fatalf("RecordSuccess failed: %v", err)
+	}
+
+	if limiter.Failures() != 0 {
+		t.Errorf("Expected failures to reset to 0, got %d", limiter.Failures())
+	}
+
+	var slept time.Duration
+	limiter.SetSleepFunc(func(d time.Duration) { slept = d })
+	limiter.Wait()
+	if slept != 0 {
+		t.Errorf("Expected no backoff after reset, got %v", slept)
+	}
+}
+
+func TestUnlockLimiterForKeysByVaultPath(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	a := UnlockLimiterFor("/vaults/work.c0")
+	b := UnlockLimiterFor("/vaults/personal.c0")
+
+	if err := a.RecordFailure(); err != nil {
+		t.Fatalf("RecordFailure failed: %v", err)
+	}
+	if err := a.RecordFailure(); err != nil {
+		t.Fatalf("RecordFailure failed: %v", err)
+	}
+
+	if b.Failures() != 0 {
+		t.Errorf("Expected failures on one vault not to affect another, got %d", b.Failures())
+	}
+
+	// Re-resolving the same vault path should see the persisted count.
+	aAgain := UnlockLimiterFor("/vaults/work.c0")
+	if aAgain.Failures() != 2 {
+		t.Errorf("Expected persisted failure count 2, got %d", aAgain.Failures())
+	}
+}
+
+func TestUnlockLimiterPersistsAcrossInstances(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "unlock-limiter.json")
+
+	first := NewUnlockLimiter(statePath)
+	first.RecordFailure()
+	first.RecordFailure()
+
+	second := NewUnlockLimiter(statePath)
+	if second.Failures() != 2 {
+		t.Errorf("Expected persisted failure count 2, got %d", second.Failures())
+	}
+}
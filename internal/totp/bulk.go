@@ -0,0 +1,44 @@
+package totp
+
+import (
+	"time"
+
+	"github.com/pquerna/otp/totp"
+)
+
+// CodeResult is the outcome of generating a TOTP code for one secret.
+type CodeResult struct {
+	Code             string
+	SecondsRemaining int
+	Err              error
+}
+
+// GenerateCodes computes codes for every secret in secrets using a single
+// captured instant, so every result shares the same period boundary and the
+// displayed countdowns stay consistent across a list view. Invalid secrets
+// yield a result with Err set rather than aborting the batch.
+func GenerateCodes(secrets map[string]string) map[string]CodeResult {
+	now := time.Now()
+	secondsRemaining := DefaultPeriod - (int(now.Unix()) % DefaultPeriod)
+
+	results := make(map[string]CodeResult, len(secrets))
+	for id, secret := range secrets {
+		results[id] = generateCodeAt(secret, now, secondsRemaining)
+	}
+	return results
+}
+
+// generateCodeAt generates a single code for secret at the given instant.
+func generateCodeAt(secret string, now time.Time, secondsRemaining int) CodeResult {
+	normalized := NormalizeSecret(secret)
+	if !ValidateSecret(normalized) {
+		return CodeResult{Err: ErrInvalidSecret}
+	}
+
+	code, err := totp.GenerateCode(normalized, now)
+	if err != nil {
+		return CodeResult{Err: ErrCodeGenerationFailed}
+	}
+
+	return CodeResult{Code: code, SecondsRemaining: secondsRemaining}
+}
@@ -0,0 +1,51 @@
+package totp
+
+import "testing"
+
+func TestGenerateCodesShareTheSameSecondsRemaining(t *testing.T) {
+	secrets := map[string]string{
+		"a": "JBSWY3DPEHPK3PXP",
+		"b": "KRSXG5CTMVRXEZLU",
+		"c": "GEZDGNBVGY3TQOJQ",
+	}
+
+	results := GenerateCodes(secrets)
+	if len(results) != len(secrets) {
+		t.Fatalf("Expected a result for every secret, got %d of %d", len(results), len(secrets))
+	}
+
+	var want int
+	for id, r := range results {
+		if r.Err != nil {
+			t.Fatalf("Unexpected error for %q: %v", id, r.Err)
+		}
+		if want == 0 {
+			want = r.SecondsRemaining
+		} else if r.SecondsRemaining != want {
+			t.Errorf("Expected every result to share the same SecondsRemaining, %q had %d, want %d", id, r.SecondsRemaining, want)
+		}
+	}
+}
+
+func TestGenerateCodesFlagsInvalidSecretsIndividually(t *testing.T) {
+	secrets := map[string]string{
+		"valid":   "JBSWY3DPEHPK3PXP",
+		"invalid": "not-base32!!",
+	}
+
+	results := GenerateCodes(secrets)
+
+	if results["valid"].Err != nil {
+		t.Errorf("Expected the valid secret to succeed, got %v", results["valid"].Err)
+	}
+	if results["valid"].Code == "" {
+		t.Error("Expected the valid secret to produce a code")
+	}
+
+	if results["invalid"].Err == nil {
+		t.Error("Expected the invalid secret to produce an error")
+	}
+	if results["invalid"].Code != "" {
+		t.Errorf("Expected no code for an invalid secret, got %q", results["invalid"].Code)
+	}
+}
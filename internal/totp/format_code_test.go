@@ -0,0 +1,36 @@
+package totp
+
+import "testing"
+
+func TestFormatCodeGroupsSixDigitsByDefault(t *testing.T) {
+	if got := FormatCode("123456"); got != "123 456" {
+		t.Errorf("FormatCode(%q) = %q, want %q", "123456", got, "123 456")
+	}
+}
+
+func TestFormatCodeGroupsEightDigitsByDefault(t *testing.T) {
+	if got := FormatCode("12345678"); got != "1234 5678" {
+		t.Errorf("FormatCode(%q) = %q, want %q", "12345678", got, "1234 5678")
+	}
+}
+
+func TestFormatCodeHonorsExplicitGroupSize(t *testing.T) {
+	if got := FormatCode("12345678", 3); got != "12345 678" {
+		t.Errorf("FormatCode(%q, 3) = %q, want %q", "12345678", got, "12345 678")
+	}
+}
+
+func TestFormatCodeLeavesUngroupableLengthsUnchanged(t *testing.T) {
+	if got := FormatCode("12345"); got != "12345" {
+		t.Errorf("FormatCode(%q) = %q, want unchanged", "12345", got)
+	}
+}
+
+func TestFormatCodeLeavesOutOfRangeGroupSizeUnchanged(t *testing.T) {
+	if got := FormatCode("123456", 0); got != "123456" {
+		t.Errorf("FormatCode(%q, 0) = %q, want unchanged", "123456", got)
+	}
+	if got := FormatCode("123456", 6); got != "123456" {
+		t.Errorf("FormatCode(%q, 6) = %q, want unchanged", "123456", got)
+	}
+}
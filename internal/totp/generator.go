@@ -4,9 +4,12 @@ package totp
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/pquerna/otp"
 	"github.com/pquerna/otp/totp"
 )
 
@@ -23,6 +26,34 @@ const DefaultPeriod = 30
 // DefaultDigits is the standard number of digits in a TOTP code.
 const DefaultDigits = 6
 
+// DefaultAlgorithm is the standard TOTP HMAC algorithm.
+const DefaultAlgorithm = "SHA1"
+
+// TOTPConfig holds non-default TOTP parameters for an entry. A zero value
+// means "use the defaults" for each field.
+type TOTPConfig struct {
+	// Algorithm is the HMAC algorithm (e.g. "SHA1", "SHA256", "SHA512").
+	Algorithm string
+	// Digits is the number of digits in a generated code.
+	Digits int
+	// Period is the code's validity period in seconds.
+	Period int
+}
+
+// withDefaults returns cfg with zero fields replaced by the package defaults.
+func (cfg TOTPConfig) withDefaults() TOTPConfig {
+	if cfg.Algorithm == "" {
+		cfg.Algorithm = DefaultAlgorithm
+	}
+	if cfg.Digits == 0 {
+		cfg.Digits = DefaultDigits
+	}
+	if cfg.Period == 0 {
+		cfg.Period = DefaultPeriod
+	}
+	return cfg
+}
+
 // GenerateCode generates a TOTP code for the given secret.
 // Returns the code, seconds remaining in the current period, and any error.
 func GenerateCode(secret string) (string, int, error) {
@@ -44,6 +75,54 @@ func GenerateCode(secret string) (string, int, error) {
 	return code, secondsRemaining, nil
 }
 
+// GenerateCodeWithConfig generates a TOTP code for secret using cfg's
+// algorithm, digit count, and period (defaulted where zero), unlike
+// GenerateCode which always uses the package defaults. Returns the code,
+// seconds remaining in the current period, and any error.
+func GenerateCodeWithConfig(secret string, cfg TOTPConfig) (string, int, error) {
+	secret = NormalizeSecret(secret)
+
+	if !ValidateSecret(secret) {
+		return "", 0, ErrInvalidSecret
+	}
+
+	cfg = cfg.withDefaults()
+	algorithm, err := parseAlgorithm(cfg.Algorithm)
+	if err != nil {
+		return "", 0, err
+	}
+
+	now := time.Now()
+	code, err := totp.GenerateCodeCustom(secret, now, totp.ValidateOpts{
+		Period:    uint(cfg.Period),
+		Skew:      1,
+		Digits:    otp.Digits(cfg.Digits),
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %v", ErrCodeGenerationFailed, err)
+	}
+
+	secondsRemaining := cfg.Period - (int(now.Unix()) % cfg.Period)
+
+	return code, secondsRemaining, nil
+}
+
+// parseAlgorithm maps a TOTPConfig.Algorithm string to the otp package's
+// Algorithm enum.
+func parseAlgorithm(algorithm string) (otp.Algorithm, error) {
+	switch strings.ToUpper(algorithm) {
+	case "SHA1":
+		return otp.AlgorithmSHA1, nil
+	case "SHA256":
+		return otp.AlgorithmSHA256, nil
+	case "SHA512":
+		return otp.AlgorithmSHA512, nil
+	default:
+		return 0, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidSecret, algorithm)
+	}
+}
+
 // ValidateSecret checks if a TOTP secret is valid base32.
 func ValidateSecret(secret string) bool {
 	secret = NormalizeSecret(secret)
@@ -71,13 +150,37 @@ func NormalizeSecret(secret string) string {
 	return secret
 }
 
-// FormatCode formats a TOTP code with a space in the middle for readability.
-// Example: "123456" -> "123 456"
-func FormatCode(code string) string {
-	if len(code) == 6 {
-		return code[:3] + " " + code[3:]
+// defaultGroupSize returns the size of the trailing chunk FormatCode splits
+// off by default for a code of the given length, so e.g. a 6-digit code
+// reads "123 456" and an 8-digit code reads "1234 5678". Lengths with no
+// sensible default (anything but 6, 7, or 8) return 0, meaning "don't group".
+func defaultGroupSize(length int) int {
+	switch length {
+	case 6:
+		return 3
+	case 7, 8:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// FormatCode formats a TOTP code with a space splitting off its trailing
+// chunk for readability, e.g. "123456" -> "123 456" and "12345678" ->
+// "1234 5678". The default trailing chunk size depends on the code's length
+// (see defaultGroupSize); pass groupSize to override it, e.g.
+// FormatCode("12345678", 3) -> "12345 678". Codes that can't be split
+// sensibly (groupSize <= 0 or >= len(code), or no default for this length)
+// are returned unchanged.
+func FormatCode(code string, groupSize ...int) string {
+	size := defaultGroupSize(len(code))
+	if len(groupSize) > 0 {
+		size = groupSize[0]
+	}
+	if size <= 0 || size >= len(code) {
+		return code
 	}
-	return code
+	return code[:len(code)-size] + " " + code[len(code)-size:]
 }
 
 // BuildOTPAuthURL builds an otpauth:// URL for the given parameters.
@@ -86,3 +189,34 @@ func BuildOTPAuthURL(secret, issuer, account string) string {
 	return fmt.Sprintf("otpauth://totp/%s:%s?secret=%s&issuer=%s&algorithm=SHA1&digits=6&period=30",
 		issuer, account, secret, issuer)
 }
+
+// BuildOTPAuthURLWithConfig builds an otpauth:// URL like BuildOTPAuthURL,
+// but with the algorithm/digits/period from cfg (defaulted where zero) and
+// proper percent-encoding of the label and issuer, so spaces and other
+// special characters round-trip through ParseOTPAuthURL correctly.
+func BuildOTPAuthURLWithConfig(secret, issuer, account string, cfg TOTPConfig) string {
+	secret = NormalizeSecret(secret)
+	cfg = cfg.withDefaults()
+
+	label := account
+	if issuer != "" {
+		label = issuer + ":" + account
+	}
+
+	q := url.Values{}
+	q.Set("secret", secret)
+	if issuer != "" {
+		q.Set("issuer", issuer)
+	}
+	q.Set("algorithm", cfg.Algorithm)
+	q.Set("digits", strconv.Itoa(cfg.Digits))
+	q.Set("period", strconv.Itoa(cfg.Period))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + label,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
@@ -0,0 +1,49 @@
+// Package totp provides TOTP (Time-based One-Time Password) functionality.
+package totp
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidOTPAuthURL is returned when a string isn't a parseable otpauth:// URL.
+var ErrInvalidOTPAuthURL = errors.New("invalid otpauth URL")
+
+// ParseOTPAuthURL parses an otpauth://totp/... URL (as produced by
+// BuildOTPAuthURL or scanned from an authenticator app's QR code) into its
+// secret, issuer, and account.
+func ParseOTPAuthURL(rawURL string) (secret, issuer, account string, err error) {
+	u, parseErr := url.Parse(strings.TrimSpace(rawURL))
+	if parseErr != nil || u.Scheme != "otpauth" || u.Host != "totp" {
+		return "", "", "", ErrInvalidOTPAuthURL
+	}
+
+	secret = NormalizeSecret(u.Query().Get("secret"))
+	if !ValidateSecret(secret) {
+		return "", "", "", ErrInvalidSecret
+	}
+
+	label, unescapeErr := url.PathUnescape(strings.TrimPrefix(u.Path, "/"))
+	if unescapeErr != nil {
+		label = strings.TrimPrefix(u.Path, "/")
+	}
+
+	issuer = u.Query().Get("issuer")
+	if idx := strings.Index(label, ":"); idx >= 0 {
+		if issuer == "" {
+			issuer = label[:idx]
+		}
+		account = label[idx+1:]
+	} else {
+		account = label
+	}
+
+	return secret, issuer, account, nil
+}
+
+// IsOTPAuthURL reports whether s looks like an otpauth:// URL rather than a
+// bare secret, so callers can decide which parser to use.
+func IsOTPAuthURL(s string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(s)), "otpauth://")
+}
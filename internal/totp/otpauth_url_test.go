@@ -0,0 +1,57 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestBuildOTPAuthURLWithConfigEncodesLabelsWithSpaces(t *testing.T) {
+	got := BuildOTPAuthURLWithConfig("JBSWY3DPEHPK3PXP", "My Company", "jane doe@example.com", TOTPConfig{})
+
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("Expected a parseable URL, got error: %v", err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" {
+		t.Fatalf("Expected otpauth://totp/..., got %q", got)
+	}
+	if u.Path != "/My Company:jane doe@example.com" {
+		t.Errorf("Expected the decoded path to contain the unescaped label, got %q", u.Path)
+	}
+	if u.Query().Get("issuer") != "My Company" {
+		t.Errorf("Expected the issuer query param to round-trip, got %q", u.Query().Get("issuer"))
+	}
+}
+
+func TestBuildOTPAuthURLWithConfigEmitsNonDefaultParameters(t *testing.T) {
+	cfg := TOTPConfig{Algorithm: "SHA512", Digits: 8, Period: 60}
+	got := BuildOTPAuthURLWithConfig("JBSWY3DPEHPK3PXP", "Issuer", "account", cfg)
+
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("Expected a parseable URL, got error: %v", err)
+	}
+	q := u.Query()
+	if q.Get("algorithm") != "SHA512" {
+		t.Errorf("Expected algorithm=SHA512, got %q", q.Get("algorithm"))
+	}
+	if q.Get("digits") != "8" {
+		t.Errorf("Expected digits=8, got %q", q.Get("digits"))
+	}
+	if q.Get("period") != "60" {
+		t.Errorf("Expected period=60, got %q", q.Get("period"))
+	}
+}
+
+func TestBuildOTPAuthURLWithConfigDefaultsZeroFields(t *testing.T) {
+	got := BuildOTPAuthURLWithConfig("JBSWY3DPEHPK3PXP", "Issuer", "account", TOTPConfig{})
+
+	u, _ := url.Parse(got)
+	q := u.Query()
+	if q.Get("algorithm") != DefaultAlgorithm {
+		t.Errorf("Expected the default algorithm %q, got %q", DefaultAlgorithm, q.Get("algorithm"))
+	}
+	if q.Get("digits") != "6" || q.Get("period") != "30" {
+		t.Errorf("Expected default digits/period, got digits=%q period=%q", q.Get("digits"), q.Get("period"))
+	}
+}
@@ -0,0 +1,32 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/batterdaysahead/cipher0/internal/config"
+)
+
+// RenderAgeBadge renders how long it's been since updated as a short,
+// color-coded badge (green fresh, yellow aging, red old), using the same
+// thresholds as a security audit would: under config.PasswordAgeFreshDays
+// is fresh, under config.PasswordAgeAgingDays is aging, anything older is
+// old.
+func RenderAgeBadge(updated, now time.Time) string {
+	days := int(now.Sub(updated).Hours() / 24)
+	if days < 0 {
+		days = 0
+	}
+
+	label := fmt.Sprintf("%dd", days)
+
+	switch {
+	case days < config.PasswordAgeFreshDays:
+		return StrengthVeryStrongStyle.Render(label)
+	case days < config.PasswordAgeAgingDays:
+		return StrengthFairStyle.Render(label)
+	default:
+		return StrengthWeakStyle.Render(label)
+	}
+}
@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/batterdaysahead/cipher0/internal/config"
+)
+
+func TestRenderAgeBadgeFreshBand(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := now.AddDate(0, 0, -(config.PasswordAgeFreshDays - 1))
+
+	got := RenderAgeBadge(updated, now)
+	want := StrengthVeryStrongStyle.Render("89d")
+	if got != want {
+		t.Errorf("Expected the fresh-band style, got %q want %q", got, want)
+	}
+}
+
+func TestRenderAgeBadgeAgingBand(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := now.AddDate(0, 0, -config.PasswordAgeFreshDays)
+
+	got := RenderAgeBadge(updated, now)
+	want := StrengthFairStyle.Render("90d")
+	if got != want {
+		t.Errorf("Expected the aging-band style at the fresh boundary, got %q want %q", got, want)
+	}
+}
+
+func TestRenderAgeBadgeOldBand(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := now.AddDate(0, 0, -config.PasswordAgeAgingDays)
+
+	got := RenderAgeBadge(updated, now)
+	want := StrengthWeakStyle.Render("180d")
+	if got != want {
+		t.Errorf("Expected the old-band style at the aging boundary, got %q want %q", got, want)
+	}
+}
+
+func TestRenderAgeBadgeClampsFutureTimestampsToZero(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := now.AddDate(0, 0, 5)
+
+	got := RenderAgeBadge(updated, now)
+	if !strings.Contains(got, "0d") {
+		t.Errorf("Expected a future update timestamp to clamp to 0d, got %q", got)
+	}
+}
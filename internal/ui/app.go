@@ -2,6 +2,7 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -34,9 +35,10 @@ type App struct {
 	height int
 
 	// UI state
-	error          string
-	success        string
-	recoveryPhrase string // Temporary storage during creation
+	error           string
+	success         string
+	recoveryPhrase  string // Temporary storage during creation
+	autoLockWarning time.Duration
 
 	// Screen models
 	loginModel           *LoginModel
@@ -108,6 +110,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Reset auto-lock timer on any keypress
 		if a.autoLock != nil {
 			a.autoLock.Reset()
+			a.autoLockWarning = 0
 		}
 
 		// Global quit
@@ -125,13 +128,19 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case VaultCreatedMsg:
 		a.vault = msg.Vault
+		a.vault.SetClipboardManager(a.clipboard)
+		a.vault.SetBackupBeforeSave(a.config.BackupBeforeSave)
+		a.vault.RequirePhraseAcknowledgement(true)
 		a.recoveryPhrase = msg.RecoveryPhrase
 		a.screen = ScreenRecoveryDisplay
 		a.recoveryDisplayModel = NewRecoveryDisplayModel(msg.RecoveryPhrase)
+		a.recoveryDisplayModel.SetClipboardManager(a.clipboard)
 		return a, a.recoveryDisplayModel.Init()
 
 	case VaultUnlockedMsg:
 		a.vault = msg.Vault
+		a.vault.SetClipboardManager(a.clipboard)
+		a.vault.SetBackupBeforeSave(a.config.BackupBeforeSave)
 		a.startAutoLock()
 
 		// For phrase-only vaults (backup files), require new password setup
@@ -145,12 +154,31 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		a.screen = ScreenMain
 		a.mainModel = NewMainModel(a.vault, a.clipboard)
-		return a, tea.Batch(a.mainModel.Init(), TOTPTick(), a.waitForAutoLock())
+		a.mainModel.SetTOTPCopyMode(a.config.TOTPCopyMode)
+		cmds := []tea.Cmd{a.mainModel.Init(), TOTPTick(), a.waitForAutoLock()}
+		if a.autoLock != nil {
+			cmds = append(cmds, AutoLockWarningTick(a.autoLock))
+		}
+		return a, tea.Batch(cmds...)
 
 	case VaultLockedMsg:
 		a.lockVault()
 		return a, nil
 
+	case AutoLockWarningMsg:
+		a.autoLockWarning = msg.Remaining
+		if a.autoLock != nil && a.autoLock.IsActive() {
+			return a, AutoLockWarningTick(a.autoLock)
+		}
+		return a, nil
+
+	case tea.BlurMsg:
+		if a.config.LockOnBlur && a.vault != nil && !a.vault.IsLocked() {
+			a.lockVault()
+			a.success = "Vault locked: terminal lost focus"
+		}
+		return a, nil
+
 	case UnlockFailedMsg:
 		a.error = msg.Error
 		return a, nil
@@ -222,6 +250,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var cmd tea.Cmd
 		a.recoveryDisplayModel, cmd = a.recoveryDisplayModel.Update(msg)
 		cmds = append(cmds, cmd)
+		if a.recoveryDisplayModel.IsConfirmed() && a.vault != nil {
+			a.vault.AcknowledgePhrase()
+		}
 
 	case ScreenRecoveryInput:
 		var cmd tea.Cmd
@@ -282,6 +313,10 @@ func (a *App) View() string {
 			a.error = ""
 		}
 		content = a.mainModel.View(a.width, a.height)
+		if a.autoLockWarning > 0 && a.autoLockWarning <= AutoLockWarningThreshold {
+			banner := WarningStyle.Render(fmt.Sprintf("⚠ Auto-locking in %ds — press any key to stay unlocked", int(a.autoLockWarning.Round(time.Second).Seconds())))
+			content = banner + "\n" + content
+		}
 	case ScreenEntry:
 		content = a.entryModel.View(a.width, a.height)
 	case ScreenSettings:
@@ -321,6 +356,7 @@ func (a *App) handleScreenChange(msg ScreenChangeMsg) (tea.Model, tea.Cmd) {
 	case ScreenMain:
 		if a.mainModel == nil && a.vault != nil {
 			a.mainModel = NewMainModel(a.vault, a.clipboard)
+			a.mainModel.SetTOTPCopyMode(a.config.TOTPCopyMode)
 		} else if a.mainModel != nil {
 			// Refresh entries when navigating back from entry/settings screens
 			a.mainModel.refreshEntries()
@@ -330,6 +366,7 @@ func (a *App) handleScreenChange(msg ScreenChangeMsg) (tea.Model, tea.Cmd) {
 	case ScreenEntry:
 		entry, _ := msg.Data.(*vault.Entry)
 		a.entryModel = NewEntryModel(a.vault, entry, a.clipboard)
+		a.entryModel.SetConfig(a.config)
 		cmd = a.entryModel.Init()
 
 	case ScreenSettings:
@@ -383,7 +420,12 @@ func (a *App) renderConfirmation() string {
 // lockVault locks the vault and returns to login
 func (a *App) lockVault() {
 	if a.vault != nil {
-		a.vault.Lock()
+		if a.mainModel != nil {
+			a.mainModel.ResetSearch()
+			a.vault.LockNow(a.mainModel)
+		} else {
+			a.vault.Lock()
+		}
 		a.vault = nil
 	}
 	if a.autoLock != nil {
@@ -396,6 +438,7 @@ func (a *App) lockVault() {
 	a.settingsModel = nil
 	a.backupModel = nil
 	a.recoveryPhrase = ""
+	a.autoLockWarning = 0
 }
 
 // startAutoLock starts the auto-lock timer
@@ -411,6 +454,7 @@ func (a *App) startAutoLock() {
 				}
 			},
 		)
+		a.autoLock.SetMode(a.config.LockMode)
 		a.autoLock.Start()
 	}
 }
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
+)
+
+func TestAutoLockWarningMsgBelowThresholdShowsBanner(t *testing.T) {
+	a := newTestAppWithUnlockedVault(t, false)
+	a.autoLock = utils.NewAutoLockTimer(time.Minute, func() {})
+	a.autoLock.Start()
+
+	a.Update(AutoLockWarningMsg{Remaining: AutoLockWarningThreshold - time.Second})
+
+	if a.autoLockWarning != AutoLockWarningThreshold-time.Second {
+		t.Fatalf("Expected autoLockWarning to be recorded, got %v", a.autoLockWarning)
+	}
+	if !strings.Contains(a.View(), "Auto-locking in") {
+		t.Error("Expected the warning banner to be rendered once remaining drops below the threshold")
+	}
+}
+
+func TestAutoLockWarningMsgAboveThresholdHidesBanner(t *testing.T) {
+	a := newTestAppWithUnlockedVault(t, false)
+	a.autoLock = utils.NewAutoLockTimer(time.Minute, func() {})
+	a.autoLock.Start()
+
+	a.Update(AutoLockWarningMsg{Remaining: AutoLockWarningThreshold + time.Second})
+
+	if strings.Contains(a.View(), "Auto-locking in") {
+		t.Error("Expected no warning banner while remaining time is above the threshold")
+	}
+}
+
+func TestKeypressClearsAutoLockWarning(t *testing.T) {
+	a := newTestAppWithUnlockedVault(t, false)
+	a.autoLock = utils.NewAutoLockTimer(time.Minute, func() {})
+	a.autoLock.Start()
+
+	a.Update(AutoLockWarningMsg{Remaining: 3 * time.Second})
+	if a.autoLockWarning == 0 {
+		t.Fatal("Expected the warning to be recorded before the keypress")
+	}
+
+	a.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+
+	if a.autoLockWarning != 0 {
+		t.Errorf("Expected any keypress to reset autoLockWarning, got %v", a.autoLockWarning)
+	}
+}
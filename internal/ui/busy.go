@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// busySpinnerFrames are the frames BusyModel cycles through for an
+// indeterminate operation.
+var busySpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// busySpinnerInterval is how often BusyModel advances to the next frame.
+const busySpinnerInterval = 100 * time.Millisecond
+
+// BusyTickMsg advances a BusyModel's spinner frame. Generation guards
+// against a stale tick from a BusyModel that has since been stopped and
+// restarted (e.g. a second long operation starting right after the first).
+type BusyTickMsg struct {
+	Generation int
+}
+
+// busyTick schedules the next BusyTickMsg for generation after
+// busySpinnerInterval.
+func busyTick(generation int) tea.Cmd {
+	return tea.Tick(busySpinnerInterval, func(t time.Time) tea.Msg {
+		return BusyTickMsg{Generation: generation}
+	})
+}
+
+// BusyModel renders a spinner and status text for a long-running operation
+// (e.g. Argon2 key derivation, MEK rotation) that a screen can compose into
+// its own view, so the UI doesn't appear to hang. Call Start to begin
+// animating, Update on every tea.Msg to advance it, View to render it, and
+// Stop when the operation finishes.
+type BusyModel struct {
+	active     bool
+	generation int
+	frame      int
+	status     string
+	// Percent, when >= 0, switches View to a determinate RenderProgressBar
+	// instead of the spinner. -1 (the default after Start) means
+	// indeterminate.
+	Percent int
+}
+
+// Start begins a new busy session with the given status text, returning the
+// tea.Cmd that drives the spinner animation. Safe to call again to restart
+// with a fresh generation (e.g. moving from one determinate step to the
+// next indeterminate one).
+func (m *BusyModel) Start(status string) tea.Cmd {
+	m.active = true
+	m.generation++
+	m.frame = 0
+	m.status = status
+	m.Percent = -1
+	return busyTick(m.generation)
+}
+
+// Stop ends the busy session. View returns "" once stopped.
+func (m *BusyModel) Stop() {
+	m.active = false
+}
+
+// Active reports whether a busy session is in progress.
+func (m *BusyModel) Active() bool {
+	return m.active
+}
+
+// SetStatus updates the status text of an in-progress session without
+// restarting the spinner animation.
+func (m *BusyModel) SetStatus(status string) {
+	m.status = status
+}
+
+// Update advances the spinner on a matching BusyTickMsg, rescheduling the
+// next tick, and ignores everything else (including a stale tick from a
+// prior generation).
+func (m *BusyModel) Update(msg tea.Msg) tea.Cmd {
+	tick, ok := msg.(BusyTickMsg)
+	if !ok || !m.active || tick.Generation != m.generation {
+		return nil
+	}
+	m.frame = (m.frame + 1) % len(busySpinnerFrames)
+	return busyTick(m.generation)
+}
+
+// View renders the current spinner frame (or, if Percent >= 0, a determinate
+// progress bar via RenderProgressBar) followed by the status text. Returns
+// "" when not active.
+func (m *BusyModel) View() string {
+	if !m.active {
+		return ""
+	}
+	if m.Percent >= 0 {
+		return RenderProgressBar(m.Percent, 15) + " " + m.status
+	}
+	return busySpinnerFrames[m.frame] + " " + m.status
+}
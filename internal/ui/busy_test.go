@@ -0,0 +1,62 @@
+package ui
+
+import "testing"
+
+func TestBusyModelStartActivatesAndRendersFirstFrame(t *testing.T) {
+	var m BusyModel
+
+	cmd := m.Start("Deriving key...")
+	if !m.Active() {
+		t.Fatal("Expected Start to activate the model")
+	}
+	if cmd == nil {
+		t.Fatal("Expected Start to return a tick command")
+	}
+	if got := m.View(); got == "" {
+		t.Error("Expected a non-empty view while active")
+	}
+}
+
+func TestBusyModelAdvancesFrameOnMatchingTick(t *testing.T) {
+	var m BusyModel
+	m.Start("Working...")
+
+	before := m.View()
+	msg := BusyTickMsg{Generation: m.generation}
+	cmd := m.Update(msg)
+	after := m.View()
+
+	if before == after {
+		t.Error("Expected the spinner frame to advance on a matching tick")
+	}
+	if cmd == nil {
+		t.Error("Expected Update to reschedule the next tick")
+	}
+}
+
+func TestBusyModelIgnoresStaleGenerationTick(t *testing.T) {
+	var m BusyModel
+	m.Start("Working...")
+	staleMsg := BusyTickMsg{Generation: m.generation - 1}
+
+	before := m.View()
+	m.Update(staleMsg)
+	after := m.View()
+
+	if before != after {
+		t.Error("Expected a stale-generation tick to be ignored")
+	}
+}
+
+func TestBusyModelStopClearsView(t *testing.T) {
+	var m BusyModel
+	m.Start("Working...")
+	m.Stop()
+
+	if m.Active() {
+		t.Error("Expected Stop to deactivate the model")
+	}
+	if got := m.View(); got != "" {
+		t.Errorf("Expected an empty view once stopped, got %q", got)
+	}
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func newTestSettingsModel(t *testing.T, password string) *SettingsModel {
+	t.Helper()
+	vaultPath := filepath.Join(t.TempDir(), "test.vault")
+	v, _, err := vault.Create(vaultPath, password)
+	if err != nil {
+		t.Fatalf("vault.Create failed: %v", err)
+	}
+	return NewSettingsModel(nil, v)
+}
+
+func TestChangePasswordWarnsOnTrivialVariation(t *testing.T) {
+	m := newTestSettingsModel(t, "correcthorsebattery")
+	m.currentPassword.SetValue("correcthorsebattery")
+	m.newPassword.SetValue("correcthorsebattery1")
+	m.confirmPassword.SetValue("correcthorsebattery1")
+
+	m, _ = m.changePassword()
+
+	if m.messageType != "success" {
+		t.Fatalf("Expected the trivially similar password change to still succeed, got messageType %q: %q", m.messageType, m.message)
+	}
+	if !strings.Contains(m.message, "similar") {
+		t.Errorf("Expected a warning about password similarity, got %q", m.message)
+	}
+}
+
+func TestChangePasswordNoWarningForDifferentPassword(t *testing.T) {
+	m := newTestSettingsModel(t, "correcthorsebattery")
+	m.currentPassword.SetValue("correcthorsebattery")
+	m.newPassword.SetValue("zxQ9!mPlantGiraffe7")
+	m.confirmPassword.SetValue("zxQ9!mPlantGiraffe7")
+
+	m, _ = m.changePassword()
+
+	if m.messageType != "success" {
+		t.Fatalf("Expected the password change to succeed, got messageType %q: %q", m.messageType, m.message)
+	}
+	if strings.Contains(m.message, "similar") {
+		t.Errorf("Expected no similarity warning for a genuinely different password, got %q", m.message)
+	}
+}
@@ -0,0 +1,113 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ConfirmResultMsg is emitted by ConfirmModel once the user confirms or
+// cancels.
+type ConfirmResultMsg struct {
+	Confirmed bool
+}
+
+// ConfirmModel is a reusable yes/no confirmation dialog for destructive
+// actions (delete, wipe, plaintext export). For especially high-risk
+// actions, construct it with a non-empty requireTypedWord so the user must
+// type that exact word before confirming, rather than a single keypress.
+type ConfirmModel struct {
+	Title   string
+	Message string
+
+	// RequireTypedWord, when non-empty, is the word the user must type
+	// into the input before enter confirms.
+	RequireTypedWord string
+
+	typedInput textinput.Model
+}
+
+// NewConfirmModel creates a confirmation dialog. Pass "" for
+// requireTypedWord for a plain yes/no prompt.
+func NewConfirmModel(title, message, requireTypedWord string) *ConfirmModel {
+	ti := textinput.New()
+	ti.Placeholder = requireTypedWord
+	ti.Width = 30
+	if requireTypedWord != "" {
+		ti.Focus()
+	}
+
+	return &ConfirmModel{
+		Title:            title,
+		Message:          message,
+		RequireTypedWord: requireTypedWord,
+		typedInput:       ti,
+	}
+}
+
+func (m *ConfirmModel) Init() tea.Cmd { return nil }
+
+// Ready reports whether the typed-word gate (if any) is currently
+// satisfied, i.e. whether enter would confirm right now.
+func (m *ConfirmModel) Ready() bool {
+	return m.RequireTypedWord == "" || m.typedInput.Value() == m.RequireTypedWord
+}
+
+func (m *ConfirmModel) Update(msg tea.Msg) (*ConfirmModel, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	if m.RequireTypedWord != "" {
+		switch keyMsg.String() {
+		case "esc":
+			return m, func() tea.Msg { return ConfirmResultMsg{Confirmed: false} }
+		case "enter":
+			if m.Ready() {
+				return m, func() tea.Msg { return ConfirmResultMsg{Confirmed: true} }
+			}
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.typedInput, cmd = m.typedInput.Update(keyMsg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "y", "Y":
+		return m, func() tea.Msg { return ConfirmResultMsg{Confirmed: true} }
+	case "n", "N", "esc":
+		return m, func() tea.Msg { return ConfirmResultMsg{Confirmed: false} }
+	}
+	return m, nil
+}
+
+func (m *ConfirmModel) View(width int) string {
+	var b strings.Builder
+
+	b.WriteString(WarningStyle.Render(m.Title))
+	b.WriteString("\n")
+	b.WriteString(SubtitleStyle.Render(m.Message))
+	b.WriteString("\n\n")
+
+	if m.RequireTypedWord != "" {
+		b.WriteString(DimStyle.Render("Type " + m.RequireTypedWord + " to confirm:"))
+		b.WriteString("\n")
+		b.WriteString(m.typedInput.View())
+		b.WriteString("\n\n")
+		b.WriteString(RenderBottomBar([][]string{
+			{"Confirm", "enter"},
+			{"Cancel", "esc"},
+		}, width))
+		return b.String()
+	}
+
+	b.WriteString(RenderBottomBar([][]string{
+		{"Confirm", "y"},
+		{"Cancel", "n/esc"},
+	}, width))
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestConfirmModelPlainYesConfirms(t *testing.T) {
+	m := NewConfirmModel("Delete Entry", "Are you sure?", "")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd == nil {
+		t.Fatal("Expected 'y' to return a command")
+	}
+	msg, ok := cmd().(ConfirmResultMsg)
+	if !ok || !msg.Confirmed {
+		t.Errorf("Expected a confirmed ConfirmResultMsg, got %#v", cmd())
+	}
+}
+
+func TestConfirmModelPlainNoCancels(t *testing.T) {
+	m := NewConfirmModel("Delete Entry", "Are you sure?", "")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if cmd == nil {
+		t.Fatal("Expected 'n' to return a command")
+	}
+	msg, ok := cmd().(ConfirmResultMsg)
+	if !ok || msg.Confirmed {
+		t.Errorf("Expected a cancelled ConfirmResultMsg, got %#v", cmd())
+	}
+}
+
+func TestConfirmModelTypedWordGatesConfirmation(t *testing.T) {
+	m := NewConfirmModel("Wipe Vault", "This cannot be undone.", "DELETE")
+
+	if m.Ready() {
+		t.Fatal("Expected the typed-word gate not to be ready before anything is typed")
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Fatal("Expected enter to be a no-op before the word is typed correctly")
+	}
+
+	for _, r := range "DELETE" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if !m.Ready() {
+		t.Fatal("Expected the gate to be satisfied once the exact word is typed")
+	}
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected enter to confirm once the typed word matches")
+	}
+	msg, ok := cmd().(ConfirmResultMsg)
+	if !ok || !msg.Confirmed {
+		t.Errorf("Expected a confirmed ConfirmResultMsg, got %#v", cmd())
+	}
+}
+
+func TestConfirmModelTypedWordEscCancels(t *testing.T) {
+	m := NewConfirmModel("Wipe Vault", "This cannot be undone.", "DELETE")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected esc to return a command")
+	}
+	msg, ok := cmd().(ConfirmResultMsg)
+	if !ok || msg.Confirmed {
+		t.Errorf("Expected a cancelled ConfirmResultMsg, got %#v", cmd())
+	}
+}
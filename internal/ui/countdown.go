@@ -0,0 +1,29 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import (
+	"fmt"
+	"time"
+)
+
+// urgentCountdownThreshold is how much time remains before FormatCountdown
+// renders the text in the urgent (warning) style.
+const urgentCountdownThreshold = 5 * time.Second
+
+// FormatCountdown renders d as an "m:ss" countdown, e.g. for "clipboard
+// clears in 0:25" or an auto-lock warning. Negative durations clamp to
+// "0:00". The last few seconds (see urgentCountdownThreshold) are styled
+// with WarningStyle to draw the eye before the clear/lock happens.
+func FormatCountdown(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+
+	total := int(d.Round(time.Second) / time.Second)
+	text := fmt.Sprintf("%d:%02d", total/60, total%60)
+
+	if d > 0 && d <= urgentCountdownThreshold {
+		return WarningStyle.Render(text)
+	}
+	return text
+}
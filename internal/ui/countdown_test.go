@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatCountdownClampsNegativeToZero(t *testing.T) {
+	got := FormatCountdown(-5 * time.Second)
+	if got != "0:00" {
+		t.Errorf("Expected a negative duration to clamp to 0:00, got %q", got)
+	}
+}
+
+func TestFormatCountdownSubSecondRoundsAndIsUrgent(t *testing.T) {
+	got := FormatCountdown(400 * time.Millisecond)
+	want := WarningStyle.Render("0:00")
+	if got != want {
+		t.Errorf("Expected a sub-second duration to round to 0:00 and render urgently, got %q want %q", got, want)
+	}
+}
+
+func TestFormatCountdownOverAMinute(t *testing.T) {
+	got := FormatCountdown(90 * time.Second)
+	if got != "1:30" {
+		t.Errorf("Expected 90s to format as 1:30, got %q", got)
+	}
+}
+
+func TestFormatCountdownIsNotUrgentAboveThreshold(t *testing.T) {
+	got := FormatCountdown(30 * time.Second)
+	if got != "0:30" {
+		t.Errorf("Expected a non-urgent duration to render plainly, got %q", got)
+	}
+}
+
+func TestFormatCountdownIsUrgentAtAndBelowThreshold(t *testing.T) {
+	got := FormatCountdown(5 * time.Second)
+	want := WarningStyle.Render("0:05")
+	if got != want {
+		t.Errorf("Expected the urgent threshold itself to render urgently, got %q want %q", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// customFieldReveal tracks the auto-re-mask generation for one revealed
+// custom field, mirroring the passwordRevealed/revealGeneration pair used
+// for the password field, but keyed per field index so revealing one
+// hidden custom field doesn't reveal (or affect the re-mask timer of) any
+// other.
+type customFieldReveal struct {
+	generation int
+}
+
+// ToggleFieldReveal reveals the custom field at fieldIndex if it's
+// currently masked, or re-masks it immediately if it's already revealed,
+// scheduling an automatic re-mask after revealFlashDuration like the
+// momentary password reveal.
+func (m *MainModel) ToggleFieldReveal(fieldIndex int) tea.Cmd {
+	if m.revealedFields == nil {
+		m.revealedFields = make(map[int]*customFieldReveal)
+	}
+
+	if _, revealed := m.revealedFields[fieldIndex]; revealed {
+		delete(m.revealedFields, fieldIndex)
+		return nil
+	}
+
+	m.fieldRevealSeq++
+	r := &customFieldReveal{generation: m.fieldRevealSeq}
+	m.revealedFields[fieldIndex] = r
+	return FieldRevealExpireAfter(revealFlashDuration, fieldIndex, r.generation)
+}
+
+// IsFieldRevealed reports whether the custom field at fieldIndex is
+// currently revealed.
+func (m *MainModel) IsFieldRevealed(fieldIndex int) bool {
+	_, ok := m.revealedFields[fieldIndex]
+	return ok
+}
+
+// handleFieldRevealExpired re-masks the field named in msg, unless it's
+// already been re-masked (or re-revealed) since msg's timer was scheduled.
+func (m *MainModel) handleFieldRevealExpired(msg FieldRevealExpiredMsg) {
+	r, ok := m.revealedFields[msg.FieldIndex]
+	if !ok || r.generation != msg.Generation {
+		return
+	}
+	delete(m.revealedFields, msg.FieldIndex)
+}
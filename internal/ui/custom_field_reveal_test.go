@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func TestToggleFieldRevealDoesNotRevealOtherFields(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+
+	m.ToggleFieldReveal(0)
+
+	if !m.IsFieldRevealed(0) {
+		t.Fatal("Expected field 0 to be revealed")
+	}
+	if m.IsFieldRevealed(1) {
+		t.Error("Expected field 1 to remain masked")
+	}
+}
+
+func TestToggleFieldRevealTwiceReMasksImmediately(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+
+	m.ToggleFieldReveal(0)
+	m.ToggleFieldReveal(0)
+
+	if m.IsFieldRevealed(0) {
+		t.Error("Expected a second toggle to re-mask the field immediately")
+	}
+}
+
+func TestFieldRevealReMasksAfterTimeout(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+
+	cmd := m.ToggleFieldReveal(2)
+	if cmd == nil {
+		t.Fatal("Expected ToggleFieldReveal to schedule an auto-re-mask")
+	}
+
+	msg, ok := cmd().(FieldRevealExpiredMsg)
+	if !ok || msg.FieldIndex != 2 {
+		t.Fatalf("Expected a FieldRevealExpiredMsg for field 2, got %#v", cmd())
+	}
+
+	m, _ = m.Update(msg)
+	if m.IsFieldRevealed(2) {
+		t.Error("Expected the timeout message to re-mask the field")
+	}
+}
+
+func TestFieldRevealExpiredMsgIgnoredIfGenerationIsStale(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+
+	cmd := m.ToggleFieldReveal(0)
+	staleMsg := cmd().(FieldRevealExpiredMsg)
+
+	// Re-reveal the same field, bumping its generation and making the
+	// first timer's message stale.
+	m.ToggleFieldReveal(0)
+	m.ToggleFieldReveal(0)
+
+	m, _ = m.Update(staleMsg)
+	if m.IsFieldRevealed(0) {
+		t.Error("Expected a stale FieldRevealExpiredMsg not to affect a newer reveal of the same field")
+	}
+}
@@ -9,11 +9,16 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/batterdaysahead/cipher0/internal/config"
 	"github.com/batterdaysahead/cipher0/internal/totp"
 	"github.com/batterdaysahead/cipher0/internal/utils"
 	"github.com/batterdaysahead/cipher0/internal/vault"
 )
 
+// presetCycleOrder is the order ctrl+p cycles through the built-in
+// password generator presets.
+var presetCycleOrder = []string{config.PresetPIN, config.PresetStrongWeb, config.PresetWifi}
+
 type EntryField int
 
 const (
@@ -37,6 +42,17 @@ type EntryModel struct {
 
 	titleInput, usernameInput, passwordInput textinput.Model
 	urlInput, totpInput, notesInput          textinput.Model
+
+	// cfg resolves named generator presets for the ctrl+p cycle; nil until
+	// SetConfig is called, in which case ctrl+p is a no-op.
+	cfg       *config.Config
+	presetIdx int
+}
+
+// SetConfig wires the app config so ctrl+p can resolve named generator
+// presets.
+func (m *EntryModel) SetConfig(cfg *config.Config) {
+	m.cfg = cfg
 }
 
 func NewEntryModel(v *vault.Vault, entry *vault.Entry, clip *utils.ClipboardManager) *EntryModel {
@@ -48,8 +64,8 @@ func NewEntryModel(v *vault.Vault, entry *vault.Entry, clip *utils.ClipboardMana
 		m.entry = &vault.Entry{
 			ID: entry.ID, Title: entry.Title, Username: entry.Username,
 			Password: entry.Password, URL: entry.URL, Notes: entry.Notes,
-			TOTPSecret: entry.TOTPSecret,
-			Created:    entry.Created, Updated: entry.Updated,
+			TOTPSecret: entry.TOTPSecret, Tags: entry.Tags, Sensitive: entry.Sensitive,
+			Created: entry.Created, Updated: entry.Updated,
 		}
 	}
 
@@ -106,6 +122,8 @@ func (m *EntryModel) Update(msg tea.Msg) (*EntryModel, tea.Cmd) {
 		case tea.KeyCtrlG:
 			pwd, _ := utils.GeneratePassword(utils.DefaultGeneratorOptions())
 			return m, func() tea.Msg { return PasswordGeneratedMsg{Password: pwd} }
+		case tea.KeyCtrlP:
+			return m, m.generateFromPreset()
 		case tea.KeyCtrlV:
 			if m.focusIdx == FieldPassword {
 				m.showPassword = !m.showPassword
@@ -123,6 +141,27 @@ func (m *EntryModel) Update(msg tea.Msg) (*EntryModel, tea.Cmd) {
 	return m, nil
 }
 
+// generateFromPreset resolves the next preset in presetCycleOrder (wrapping
+// around) and generates a password from it. A no-op if SetConfig was never
+// called or the preset fails to resolve/generate.
+func (m *EntryModel) generateFromPreset() tea.Cmd {
+	if m.cfg == nil || len(presetCycleOrder) == 0 {
+		return nil
+	}
+	name := presetCycleOrder[m.presetIdx%len(presetCycleOrder)]
+	m.presetIdx++
+
+	opts, err := m.cfg.GeneratorPreset(name)
+	if err != nil {
+		return nil
+	}
+	pwd, err := utils.GeneratePassword(opts)
+	if err != nil {
+		return nil
+	}
+	return func() tea.Msg { return PasswordGeneratedMsg{Password: pwd} }
+}
+
 func (m *EntryModel) blur() {
 	inputs := []*textinput.Model{&m.titleInput, &m.usernameInput, &m.passwordInput, &m.urlInput, &m.totpInput, &m.notesInput}
 	inputs[m.focusIdx].Blur()
@@ -178,8 +217,8 @@ func (m *EntryModel) save() (*EntryModel, tea.Cmd) {
 
 	m.entry.Title = title
 	m.entry.Username = username
-	m.entry.Password = password
-	m.entry.URL = strings.TrimSpace(m.urlInput.Value())
+	m.entry.ChangePassword(password)
+	m.entry.URL = utils.NormalizeURL(m.urlInput.Value())
 	m.entry.TOTPSecret = strings.TrimSpace(m.totpInput.Value())
 	m.entry.Notes = m.notesInput.Value()
 
@@ -294,6 +333,7 @@ func (m *EntryModel) View(width, height int) string {
 		{"Next", "tab"},
 		{"Save", "ctrl+s"},
 		{"Generate", "ctrl+g"},
+		{"Preset", "ctrl+p"},
 		{"Cancel", "esc"},
 	}, contentWidth))
 
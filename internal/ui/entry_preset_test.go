@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/batterdaysahead/cipher0/internal/config"
+	"github.com/batterdaysahead/cipher0/internal/utils"
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func newTestEntryModel(t *testing.T) *EntryModel {
+	t.Helper()
+	v, _, err := vault.Create(filepath.Join(t.TempDir(), "test.vault"), "master-password-1")
+	if err != nil {
+		t.Fatalf("vault.Create failed: %v", err)
+	}
+	return NewEntryModel(v, nil, utils.NewClipboardManager(0))
+}
+
+func TestCtrlPIsNoOpWithoutConfig(t *testing.T) {
+	m := newTestEntryModel(t)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	if cmd != nil {
+		t.Error("Expected ctrl+p to be a no-op before SetConfig is called")
+	}
+}
+
+func TestCtrlPGeneratesFromPresetAndCycles(t *testing.T) {
+	m := newTestEntryModel(t)
+	m.SetConfig(&config.Config{})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	if cmd == nil {
+		t.Fatal("Expected ctrl+p to return a command once a config is set")
+	}
+	msg, ok := cmd().(PasswordGeneratedMsg)
+	if !ok || msg.Password == "" {
+		t.Fatalf("Expected a PasswordGeneratedMsg with a password, got %#v", cmd())
+	}
+
+	m, _ = m.Update(msg)
+	if m.passwordInput.Value() != msg.Password {
+		t.Errorf("Expected the generated password to be applied to the password field, got %q", m.passwordInput.Value())
+	}
+
+	if m.presetIdx != 1 {
+		t.Errorf("Expected the preset cycle to advance, got index %d", m.presetIdx)
+	}
+}
@@ -0,0 +1,34 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import "strings"
+
+// HighlightMatch wraps every case-insensitive occurrence of query within
+// text in HighlightStyle, leaving the rest of text unstyled. An empty query
+// returns text unchanged (an empty query would otherwise "match"
+// everywhere, highlighting nothing usefully).
+func HighlightMatch(text, query string) string {
+	if query == "" {
+		return text
+	}
+
+	lowerText := strings.ToLower(text)
+	lowerQuery := strings.ToLower(query)
+
+	var b strings.Builder
+	i := 0
+	for {
+		idx := strings.Index(lowerText[i:], lowerQuery)
+		if idx < 0 {
+			b.WriteString(text[i:])
+			break
+		}
+		matchStart := i + idx
+		matchEnd := matchStart + len(query)
+		b.WriteString(text[i:matchStart])
+		b.WriteString(HighlightStyle.Render(text[matchStart:matchEnd]))
+		i = matchEnd
+	}
+
+	return b.String()
+}
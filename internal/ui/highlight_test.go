@@ -0,0 +1,35 @@
+package ui
+
+import "testing"
+
+func TestHighlightMatchStylesTheMatchedRegion(t *testing.T) {
+	got := HighlightMatch("GitHub Login", "hub")
+	want := "Git" + HighlightStyle.Render("Hub") + " Login"
+	if got != want {
+		t.Errorf("Expected the case-insensitive match to be styled, got %q want %q", got, want)
+	}
+}
+
+func TestHighlightMatchEmptyQueryReturnsTextUnchanged(t *testing.T) {
+	text := "GitHub Login"
+	got := HighlightMatch(text, "")
+	if got != text {
+		t.Errorf("Expected an empty query to leave the text unchanged, got %q", got)
+	}
+}
+
+func TestHighlightMatchHandlesMultipleOccurrences(t *testing.T) {
+	got := HighlightMatch("ababab", "ab")
+	want := HighlightStyle.Render("ab") + HighlightStyle.Render("ab") + HighlightStyle.Render("ab")
+	if got != want {
+		t.Errorf("Expected every non-overlapping occurrence to be highlighted, got %q want %q", got, want)
+	}
+}
+
+func TestHighlightMatchNoOccurrenceReturnsTextUnchanged(t *testing.T) {
+	text := "GitHub Login"
+	got := HighlightMatch(text, "zzz")
+	if got != text {
+		t.Errorf("Expected no match to leave the text unchanged, got %q", got)
+	}
+}
@@ -0,0 +1,72 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+// Column identifies an entry field that can be shown in a list row.
+type Column int
+
+const (
+	ColumnTitle Column = iota
+	ColumnUsername
+	ColumnURL
+	ColumnUpdated
+)
+
+// valueFor returns e's value for this column, formatted for display.
+func (c Column) valueFor(e *vault.Entry) string {
+	switch c {
+	case ColumnTitle:
+		return e.Title
+	case ColumnUsername:
+		return e.Username
+	case ColumnURL:
+		return e.URL
+	case ColumnUpdated:
+		return e.Updated.Format("2006-01-02")
+	default:
+		return ""
+	}
+}
+
+// ListColumns configures which entry fields render in a list view, and how
+// wide each one is. Columns and Widths are matched by index; a column past
+// the end of Widths falls back to defaultColumnWidth.
+type ListColumns struct {
+	Columns []Column
+	Widths  []int
+}
+
+// defaultColumnWidth is used for any column DefaultListColumns or a caller
+// didn't give an explicit width.
+const defaultColumnWidth = 20
+
+// DefaultListColumns returns the single-column title-only layout the list
+// view used before column configuration existed.
+func DefaultListColumns() ListColumns {
+	return ListColumns{
+		Columns: []Column{ColumnTitle},
+		Widths:  []int{defaultColumnWidth},
+	}
+}
+
+// RenderEntryRow renders e as a single row with one field per column in
+// cols, each truncated and padded to its width in widths (matched by
+// index; defaultColumnWidth is used past the end of widths), separated by a
+// single space. selected applies the same highlighting as RenderListItem.
+func RenderEntryRow(e *vault.Entry, cols []Column, widths []int, selected bool) string {
+	parts := make([]string, len(cols))
+	for i, c := range cols {
+		w := defaultColumnWidth
+		if i < len(widths) {
+			w = widths[i]
+		}
+		parts[i] = fmt.Sprintf("%-*s", w, TruncateWithEllipsis(c.valueFor(e), w))
+	}
+	return RenderListItem(strings.Join(parts, " "), selected)
+}
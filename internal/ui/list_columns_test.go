@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func TestRenderEntryRowRendersColumnsInOrder(t *testing.T) {
+	e := vault.NewEntry("Example")
+	e.Username = "jane"
+	e.URL = "https://example.com"
+
+	row := RenderEntryRow(e, []Column{ColumnTitle, ColumnUsername, ColumnURL}, []int{10, 10, 25}, false)
+
+	titleIdx := strings.Index(row, "Example")
+	userIdx := strings.Index(row, "jane")
+	urlIdx := strings.Index(row, "https://example.com")
+	if titleIdx < 0 || userIdx < 0 || urlIdx < 0 {
+		t.Fatalf("Expected all column values to appear in the row, got %q", row)
+	}
+	if !(titleIdx < userIdx && userIdx < urlIdx) {
+		t.Errorf("Expected columns to render in the requested order, got %q", row)
+	}
+}
+
+func TestRenderEntryRowTruncatesToColumnWidth(t *testing.T) {
+	e := vault.NewEntry("A Very Long Entry Title That Overflows")
+
+	row := RenderEntryRow(e, []Column{ColumnTitle}, []int{10}, false)
+
+	if strings.Contains(row, "Overflows") {
+		t.Errorf("Expected the title to be truncated to its column width, got %q", row)
+	}
+	if !strings.Contains(row, "…") {
+		t.Errorf("Expected truncation to add an ellipsis, got %q", row)
+	}
+}
+
+func TestRenderEntryRowAppliesSelectedStyle(t *testing.T) {
+	e := vault.NewEntry("Example")
+
+	selected := RenderEntryRow(e, []Column{ColumnTitle}, []int{10}, true)
+	unselected := RenderEntryRow(e, []Column{ColumnTitle}, []int{10}, false)
+
+	if selected == unselected {
+		t.Error("Expected selected rows to render differently from unselected rows")
+	}
+	if !strings.Contains(selected, ">") {
+		t.Errorf("Expected a selected row to contain the selection marker, got %q", selected)
+	}
+}
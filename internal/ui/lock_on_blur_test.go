@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/batterdaysahead/cipher0/internal/config"
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func newTestAppWithUnlockedVault(t *testing.T, lockOnBlur bool) *App {
+	t.Helper()
+	vaultPath := filepath.Join(t.TempDir(), "test.vault")
+	v, _, err := vault.Create(vaultPath, "master-password-1")
+	if err != nil {
+		t.Fatalf("vault.Create failed: %v", err)
+	}
+
+	a := NewApp(vaultPath, &config.Config{LockOnBlur: lockOnBlur})
+	a.vault = v
+	a.screen = ScreenMain
+	a.mainModel = NewMainModel(v, a.clipboard)
+	return a
+}
+
+func TestBlurLocksVaultWhenLockOnBlurEnabled(t *testing.T) {
+	a := newTestAppWithUnlockedVault(t, true)
+
+	a.Update(tea.BlurMsg{})
+
+	if a.vault != nil {
+		t.Fatal("Expected the vault to be locked after a blur message")
+	}
+	if a.screen != ScreenLogin {
+		t.Fatalf("Expected to return to the login screen, got %v", a.screen)
+	}
+}
+
+func TestBlurIsIgnoredWhenLockOnBlurDisabled(t *testing.T) {
+	a := newTestAppWithUnlockedVault(t, false)
+
+	a.Update(tea.BlurMsg{})
+
+	if a.vault == nil {
+		t.Fatal("Expected the vault to remain unlocked when LockOnBlur is disabled")
+	}
+	if a.screen != ScreenMain {
+		t.Fatalf("Expected to remain on the main screen, got %v", a.screen)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/batterdaysahead/cipher0/internal/config"
 	"github.com/batterdaysahead/cipher0/internal/totp"
 	"github.com/batterdaysahead/cipher0/internal/utils"
 	"github.com/batterdaysahead/cipher0/internal/vault"
@@ -32,7 +33,155 @@ type MainModel struct {
 	message          string
 	messageType      string
 	passwordRevealed bool
+	revealMode       RevealMode
+	revealGeneration int
+	totpCopyMode     config.TOTPCopyMode
 	showQRCode       bool
+	reauthMode       bool
+	reauthInput      textinput.Model
+	// reauthForRevealAll marks that the in-flight reauthMode prompt is
+	// gating startRevealAll rather than a single sensitive entry's reveal.
+	reauthForRevealAll bool
+
+	// revealAll, unlike passwordRevealed, stays set across navigation so
+	// every entry the user looks at shows its password unmasked, instead of
+	// needing 'r' pressed again each time. Gated behind re-auth and
+	// auto-expires like a flash reveal.
+	revealAll           bool
+	revealAllGeneration int
+
+	// selectedIDs holds the IDs of entries checked for a batch action (e.g.
+	// delete/tag), independent of selectedIdx, which is just the cursor.
+	selectedIDs map[string]struct{}
+
+	// revealedFields tracks which hidden custom fields (by index into the
+	// current entry's CustomFields) are currently revealed, each with its
+	// own auto-re-mask timer. See ToggleFieldReveal.
+	revealedFields map[int]*customFieldReveal
+	// fieldRevealSeq is a monotonic counter assigning each reveal session a
+	// unique generation, so a stale timer from an earlier reveal of the
+	// same field index can never re-mask a later one.
+	fieldRevealSeq int
+}
+
+// ToggleSelect flips whether the entry with the given ID is checked for a
+// batch action.
+func (m *MainModel) ToggleSelect(id string) {
+	if m.selectedIDs == nil {
+		m.selectedIDs = make(map[string]struct{})
+	}
+	if _, ok := m.selectedIDs[id]; ok {
+		delete(m.selectedIDs, id)
+	} else {
+		m.selectedIDs[id] = struct{}{}
+	}
+}
+
+// SelectedIDs returns the IDs currently checked for a batch action.
+func (m *MainModel) SelectedIDs() []string {
+	ids := make([]string, 0, len(m.selectedIDs))
+	for id := range m.selectedIDs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// IsSelected reports whether the entry with the given ID is checked.
+func (m *MainModel) IsSelected(id string) bool {
+	_, ok := m.selectedIDs[id]
+	return ok
+}
+
+// ClearSelection unchecks every entry.
+func (m *MainModel) ClearSelection() {
+	m.selectedIDs = nil
+}
+
+// RevealMode controls how pressing 'r' reveals a password in the list.
+type RevealMode int
+
+const (
+	// RevealToggle reveals the password until 'r' is pressed again.
+	RevealToggle RevealMode = iota
+	// RevealMomentary shows the password only briefly, approximating a
+	// "hold to reveal" gesture. Terminals don't reliably report key-up
+	// events, so this re-masks after revealFlashDuration like RevealFlash.
+	RevealMomentary
+	// RevealFlash shows the password for a fixed duration, then re-masks.
+	RevealFlash
+)
+
+// revealFlashDuration is how long RevealMomentary/RevealFlash keep a
+// password visible before re-masking it.
+const revealFlashDuration = 2 * time.Second
+
+// revealAllDuration is how long a reveal-all session stays active before
+// every password auto-re-masks, regardless of navigation.
+const revealAllDuration = 10 * time.Second
+
+// startRevealAll turns on revealAll, scheduling an automatic re-mask after
+// revealAllDuration. Callers must have already re-verified the master
+// password; see the "R" key handler.
+func (m *MainModel) startRevealAll() tea.Cmd {
+	m.revealAll = true
+	m.revealAllGeneration++
+	return RevealAllExpireAfter(revealAllDuration, m.revealAllGeneration)
+}
+
+// SetRevealMode sets how pressing 'r' reveals a password.
+func (m *MainModel) SetRevealMode(mode RevealMode) {
+	m.revealMode = mode
+}
+
+// SetTOTPCopyMode sets what copyTOTP puts on the clipboard.
+func (m *MainModel) SetTOTPCopyMode(mode config.TOTPCopyMode) {
+	m.totpCopyMode = mode
+}
+
+// maskSelectedEntry re-masks the currently selected entry's single-entry
+// reveal (passwordRevealed), without touching revealAll, which is meant to
+// survive navigation. Called whenever the selection moves, so revealing a
+// non-sensitive entry and then navigating onto a Sensitive one never shows
+// its password without going through the reauth gate: moving selection
+// always starts from "masked," so a Sensitive entry under the cursor is
+// re-masked/re-gated by the normal 'r' handler rather than inheriting the
+// previous entry's revealed state.
+func (m *MainModel) maskSelectedEntry() {
+	m.passwordRevealed = false
+	m.revealGeneration++
+}
+
+// CancelReveal re-masks any revealed password immediately and bumps both
+// generation counters so an already-scheduled re-mask message from a prior
+// reveal is ignored when it arrives. It implements vault.RevealCanceler so
+// a manual lock can cancel pending reveals before the vault is destroyed.
+func (m *MainModel) CancelReveal() {
+	m.passwordRevealed = false
+	m.revealAll = false
+	m.revealGeneration++
+	m.revealAllGeneration++
+	m.revealedFields = nil
+}
+
+// ResetSearch clears the active search query and filter, returning the
+// entry list to its unfiltered state. Used when locking the vault so a
+// subsequent unlock doesn't resume mid-search.
+func (m *MainModel) ResetSearch() {
+	m.searchMode = false
+	m.searchInput.SetValue("")
+	m.searchInput.Blur()
+	m.applyFilter()
+}
+
+// reveal shows the password, scheduling an automatic re-mask unless
+// revealMode is RevealToggle.
+func (m *MainModel) reveal() tea.Cmd {
+	m.passwordRevealed = true
+	if m.revealMode == RevealToggle {
+		return nil
+	}
+	m.revealGeneration++
+	return RevealExpireAfter(revealFlashDuration, m.revealGeneration)
 }
 
 func NewMainModel(v *vault.Vault, clip *utils.ClipboardManager) *MainModel {
@@ -42,7 +191,17 @@ func NewMainModel(v *vault.Vault, clip *utils.ClipboardManager) *MainModel {
 	si.Prompt = ""
 	si.PlaceholderStyle = InputPlaceholderStyle
 	si.TextStyle = lipgloss.NewStyle().Foreground(ColorWhite)
-	m := &MainModel{vault: v, clipboard: clip, searchInput: si}
+
+	ri := textinput.New()
+	ri.Placeholder = "Master password"
+	ri.Width = 30
+	ri.Prompt = ""
+	ri.EchoMode = textinput.EchoPassword
+	ri.EchoCharacter = '•'
+	ri.PlaceholderStyle = InputPlaceholderStyle
+	ri.TextStyle = lipgloss.NewStyle().Foreground(ColorWhite)
+
+	m := &MainModel{vault: v, clipboard: clip, searchInput: si, reauthInput: ri}
 	m.refreshEntries()
 	return m
 }
@@ -50,7 +209,7 @@ func NewMainModel(v *vault.Vault, clip *utils.ClipboardManager) *MainModel {
 func (m *MainModel) Init() tea.Cmd { return nil }
 
 func (m *MainModel) refreshEntries() {
-	m.entries = m.vault.Entries()
+	m.entries = m.vault.Entries().Active()
 	m.applyFilter()
 }
 
@@ -104,6 +263,19 @@ func (m *MainModel) Update(msg tea.Msg) (*MainModel, tea.Cmd) {
 		m.refreshEntries()
 		m.message, m.messageType = "Deleted", "success"
 		return m, ClearNotificationAfter(notificationDuration)
+	case RevealExpiredMsg:
+		if msg.Generation == m.revealGeneration {
+			m.passwordRevealed = false
+		}
+		return m, nil
+	case RevealAllExpiredMsg:
+		if msg.Generation == m.revealAllGeneration {
+			m.revealAll = false
+		}
+		return m, nil
+	case FieldRevealExpiredMsg:
+		m.handleFieldRevealExpired(msg)
+		return m, nil
 	case tea.KeyMsg:
 		m.message = ""
 		// Handle QR code overlay - escape or 'o' closes it
@@ -113,6 +285,9 @@ func (m *MainModel) Update(msg tea.Msg) (*MainModel, tea.Cmd) {
 			}
 			return m, nil
 		}
+		if m.reauthMode {
+			return m.handleReauth(msg)
+		}
 		if m.searchMode {
 			return m.handleSearch(msg)
 		}
@@ -126,6 +301,7 @@ func (m *MainModel) Update(msg tea.Msg) (*MainModel, tea.Cmd) {
 				if m.selectedIdx >= m.pageEnd() {
 					m.currentPage++
 				}
+				m.maskSelectedEntry()
 			}
 		case "k", "up":
 			if m.selectedIdx > 0 {
@@ -134,18 +310,25 @@ func (m *MainModel) Update(msg tea.Msg) (*MainModel, tea.Cmd) {
 				if m.selectedIdx < m.pageStart() {
 					m.currentPage--
 				}
+				m.maskSelectedEntry()
 			}
 		case "[", "left":
 			// Previous page
 			if m.currentPage > 0 {
 				m.currentPage--
 				m.selectedIdx = m.pageStart()
+				m.maskSelectedEntry()
 			}
 		case "]", "right":
 			// Next page
 			if m.currentPage < m.totalPages()-1 {
 				m.currentPage++
 				m.selectedIdx = m.pageStart()
+				m.maskSelectedEntry()
+			}
+		case " ":
+			if len(m.filteredEntries) > 0 {
+				m.ToggleSelect(m.filteredEntries[m.selectedIdx].ID)
 			}
 		case "ctrl+f":
 			m.searchMode = true
@@ -162,7 +345,26 @@ func (m *MainModel) Update(msg tea.Msg) (*MainModel, tea.Cmd) {
 				return m, RequestConfirmation("Delete", "Delete "+e.Title+"?", m.delete(e.ID), nil)
 			}
 		case "r":
-			m.passwordRevealed = !m.passwordRevealed
+			if m.passwordRevealed {
+				m.passwordRevealed = false
+				m.revealGeneration++
+			} else if len(m.filteredEntries) > 0 && m.filteredEntries[m.selectedIdx].Sensitive {
+				m.reauthMode = true
+				m.reauthInput.SetValue("")
+				m.reauthInput.Focus()
+			} else {
+				return m, m.reveal()
+			}
+		case "R":
+			if m.revealAll {
+				m.revealAll = false
+				m.revealAllGeneration++
+			} else if len(m.filteredEntries) > 0 {
+				m.reauthMode = true
+				m.reauthForRevealAll = true
+				m.reauthInput.SetValue("")
+				m.reauthInput.Focus()
+			}
 		case "p":
 			return m, m.copyPassword()
 		case "t":
@@ -176,6 +378,8 @@ func (m *MainModel) Update(msg tea.Msg) (*MainModel, tea.Cmd) {
 			} else {
 				m.message, m.messageType = "No TOTP configured for this entry", "error"
 			}
+		case "O":
+			return m, m.copyOTPAuthURL()
 		case "b":
 			return m, NavigateTo(ScreenBackup, nil)
 		case "s":
@@ -203,6 +407,37 @@ func (m *MainModel) handleSearch(msg tea.KeyMsg) (*MainModel, tea.Cmd) {
 	return m, tea.Batch(cmd, TOTPTick())
 }
 
+// handleReauth drives the inline master-password prompt gating reveal of a
+// sensitive entry or a reveal-all session. Enter verifies the password via
+// the vault; Esc cancels.
+func (m *MainModel) handleReauth(msg tea.KeyMsg) (*MainModel, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.reauthMode = false
+		m.reauthForRevealAll = false
+		m.reauthInput.Blur()
+		return m, nil
+	case tea.KeyEnter:
+		password := m.reauthInput.Value()
+		m.reauthMode = false
+		forRevealAll := m.reauthForRevealAll
+		m.reauthForRevealAll = false
+		m.reauthInput.Blur()
+		if err := m.vault.VerifyPassword(password); err != nil {
+			m.message, m.messageType = "Incorrect password", "error"
+			return m, ClearNotificationAfter(notificationDuration)
+		}
+		if forRevealAll {
+			return m, m.startRevealAll()
+		}
+		return m, m.reveal()
+	}
+
+	var cmd tea.Cmd
+	m.reauthInput, cmd = m.reauthInput.Update(msg)
+	return m, cmd
+}
+
 func (m *MainModel) delete(id string) tea.Cmd {
 	return func() tea.Msg {
 		_ = m.vault.DeleteEntry(id)
@@ -244,15 +479,31 @@ func (m *MainModel) copyTOTP() tea.Cmd {
 		m.message, m.messageType = "No TOTP configured for this entry", "error"
 		return nil
 	}
-	code, _, err := totp.GenerateCode(e.TOTPSecret)
+	content, err := e.TOTPCopyContent(m.totpCopyMode)
 	if err == nil {
-		_ = m.clipboard.Copy(code)
+		_ = m.clipboard.Copy(content)
 		return func() tea.Msg { return ClipboardCopiedMsg{Label: "TOTP"} }
 	}
 	m.message, m.messageType = "Failed to generate TOTP code", "error"
 	return nil
 }
 
+// copyOTPAuthURL copies the selected entry's TOTP setup as an otpauth://
+// URL, so it can be pasted into another authenticator app or device.
+func (m *MainModel) copyOTPAuthURL() tea.Cmd {
+	if len(m.filteredEntries) == 0 {
+		return nil
+	}
+	e := m.filteredEntries[m.selectedIdx]
+	otpURL, err := e.OTPAuthURL()
+	if err != nil {
+		m.message, m.messageType = "No TOTP configured for this entry", "error"
+		return nil
+	}
+	_ = m.clipboard.Copy(otpURL)
+	return func() tea.Msg { return ClipboardCopiedMsg{Label: "otpauth URL"} }
+}
+
 func (m *MainModel) View(width, height int) string {
 	contentWidth := max(60, min(90, width-4))
 
@@ -261,6 +512,11 @@ func (m *MainModel) View(width, height int) string {
 		return m.renderQRCodeView(width, height, contentWidth)
 	}
 
+	// Show master-password re-auth prompt for sensitive entries
+	if m.reauthMode && len(m.filteredEntries) > 0 {
+		return m.renderReauthView(width, height, contentWidth)
+	}
+
 	var b strings.Builder
 
 	// Header
@@ -289,7 +545,15 @@ func (m *MainModel) View(width, height int) string {
 
 		for i := start; i < end; i++ {
 			e := m.filteredEntries[i]
-			leftCol.WriteString(RenderListItem(TruncateWithEllipsis(e.Title, 20), i == m.selectedIdx))
+			marker := IconBox
+			if m.IsSelected(e.ID) {
+				marker = IconBoxFill
+			}
+			label := marker + " " + TruncateWithEllipsis(e.Title, 20)
+			if e.Sensitive {
+				label = marker + " 🔒 " + TruncateWithEllipsis(e.Title, 20)
+			}
+			leftCol.WriteString(RenderListItem(label, i == m.selectedIdx))
 			leftCol.WriteString("\n")
 		}
 
@@ -321,7 +585,7 @@ func (m *MainModel) View(width, height int) string {
 		// Password (masked or revealed)
 		if e.Password != "" {
 			var pwdDisplay string
-			if m.passwordRevealed {
+			if m.passwordRevealed || m.revealAll {
 				pwdDisplay = e.Password
 			} else {
 				pwdDisplay = strings.Repeat("•", 12)
@@ -338,18 +602,13 @@ func (m *MainModel) View(width, height int) string {
 		rightCol.WriteString(RenderDetailRow("Created", e.Created.Format("2006-01-02")))
 		rightCol.WriteString("\n")
 
-		// TOTP if present
+		// TOTP if present. The raw secret is never rendered, only the live code.
 		if e.HasTOTP() {
-			code, remaining, _ := totp.GenerateCode(e.TOTPSecret)
-			var totpStr string
-			if remaining <= 5 {
-				totpStr = TOTPTimerUrgentStyle.Render(totp.FormatCode(code)) + DimStyle.Render(fmt.Sprintf(" %ds", remaining))
-			} else {
-				totpStr = TOTPCodeStyle.Render(totp.FormatCode(code)) + DimStyle.Render(fmt.Sprintf(" %ds", remaining))
+			if totpStr, err := FormatTOTPLine(e.TOTPSecret); err == nil {
+				rightCol.WriteString("\n")
+				rightCol.WriteString(RenderDetailRow("TOTP", totpStr))
+				rightCol.WriteString("\n")
 			}
-			rightCol.WriteString("\n")
-			rightCol.WriteString(RenderDetailRow("TOTP", totpStr))
-			rightCol.WriteString("\n")
 		}
 
 		// Notes
@@ -391,10 +650,12 @@ func (m *MainModel) View(width, height int) string {
 		{"Edit", "e"},
 		{"Delete", "d"},
 		{"Reveal", "r"},
+		{"Reveal All", "R"},
 		{"Pass", "p"},
 		{"User", "u"},
 		{"TOTP", "t"},
 		{"QR", "o"},
+		{"Share", "O"},
 	}, contentWidth))
 	b.WriteString("\n\n")
 	b.WriteString(RenderBottomBar([][]string{
@@ -409,6 +670,27 @@ func (m *MainModel) View(width, height int) string {
 	return centerContent(b.String(), width, height)
 }
 
+// renderReauthView renders a full-screen master-password prompt gating
+// reveal of a sensitive entry.
+func (m *MainModel) renderReauthView(width, height, contentWidth int) string {
+	e := m.filteredEntries[m.selectedIdx]
+
+	var b strings.Builder
+
+	b.WriteString(RenderHeader("RE-AUTHENTICATE", e.Title, contentWidth))
+	b.WriteString("\n\n")
+	b.WriteString(DimStyle.Render("This entry is marked sensitive. Enter your master password to reveal it:"))
+	b.WriteString("\n\n")
+	b.WriteString(m.reauthInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(RenderBottomBar([][]string{
+		{"Confirm", "enter"},
+		{"Cancel", "esc"},
+	}, contentWidth))
+
+	return centerContent(b.String(), width, height)
+}
+
 // renderQRCodeView renders a full-screen QR code view for TOTP setup.
 func (m *MainModel) renderQRCodeView(width, height, contentWidth int) string {
 	e := m.filteredEntries[m.selectedIdx]
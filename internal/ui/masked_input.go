@@ -0,0 +1,92 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MaskedInput wraps a textinput.Model with consistent masking, reveal
+// toggling, and paste handling for password-like fields throughout the app.
+type MaskedInput struct {
+	Input textinput.Model
+
+	// Masked controls whether the value is rendered as bullets.
+	Masked bool
+
+	// RevealKey is the key that toggles Masked. Defaults to "ctrl+r".
+	RevealKey string
+}
+
+// NewMaskedInput creates a masked input field, starting masked.
+func NewMaskedInput() MaskedInput {
+	ti := textinput.New()
+	ti.EchoMode = textinput.EchoPassword
+	ti.EchoCharacter = '•'
+	ti.Prompt = ""
+
+	return MaskedInput{
+		Input:     ti,
+		Masked:    true,
+		RevealKey: "ctrl+r",
+	}
+}
+
+// Update handles key input, toggling reveal on RevealKey and otherwise
+// delegating to the wrapped textinput.Model.
+func (m MaskedInput) Update(msg tea.Msg) (MaskedInput, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == m.RevealKey {
+		m.ToggleReveal()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.Input, cmd = m.Input.Update(msg)
+	return m, cmd
+}
+
+// ToggleReveal flips Masked and switches the underlying echo mode to match.
+func (m *MaskedInput) ToggleReveal() {
+	m.Masked = !m.Masked
+	if m.Masked {
+		m.Input.EchoMode = textinput.EchoPassword
+	} else {
+		m.Input.EchoMode = textinput.EchoNormal
+	}
+}
+
+// Value returns the raw (unmasked) underlying value regardless of Masked.
+func (m MaskedInput) Value() string {
+	return m.Input.Value()
+}
+
+// SetValue sets the underlying value.
+func (m *MaskedInput) SetValue(s string) {
+	m.Input.SetValue(s)
+}
+
+// Focus focuses the input, showing the caret.
+func (m *MaskedInput) Focus() tea.Cmd {
+	return m.Input.Focus()
+}
+
+// Blur removes focus from the input.
+func (m *MaskedInput) Blur() {
+	m.Input.Blur()
+}
+
+// Reset clears the value.
+func (m *MaskedInput) Reset() {
+	m.Input.Reset()
+}
+
+// SetWidth sets the rendered width of the input.
+func (m *MaskedInput) SetWidth(w int) {
+	m.Input.Width = w
+}
+
+// View renders the input, showing bullets while Masked and the caret
+// whenever the field is focused.
+func (m MaskedInput) View() string {
+	return m.Input.View()
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMaskedInputMasksTypedRunesUntilRevealed(t *testing.T) {
+	m := NewMaskedInput()
+	m.Focus()
+
+	for _, r := range "secret" {
+		var cmd tea.Cmd
+		m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		_ = cmd
+	}
+
+	if m.Value() != "secret" {
+		t.Fatalf("Expected Value() to return the raw input, got %q", m.Value())
+	}
+	if strings.Contains(m.View(), "secret") {
+		t.Errorf("Expected masked view not to contain the raw value, got %q", m.View())
+	}
+
+	m.ToggleReveal()
+
+	if m.Masked {
+		t.Fatal("Expected Masked to be false after ToggleReveal")
+	}
+	if !strings.Contains(m.View(), "secret") {
+		t.Errorf("Expected revealed view to contain the raw value, got %q", m.View())
+	}
+}
+
+func TestMaskedInputRevealKeyTogglesViaUpdate(t *testing.T) {
+	m := NewMaskedInput()
+	m.Focus()
+	m.SetValue("hunter2")
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	if m.Masked {
+		t.Fatal("Expected ctrl+r to reveal the input")
+	}
+	if !strings.Contains(m.View(), "hunter2") {
+		t.Errorf("Expected revealed view to contain the raw value, got %q", m.View())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if !m.Masked {
+		t.Fatal("Expected a second ctrl+r to re-mask the input")
+	}
+	if strings.Contains(m.View(), "hunter2") {
+		t.Errorf("Expected masked view not to contain the raw value, got %q", m.View())
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/batterdaysahead/cipher0/internal/utils"
 	"github.com/batterdaysahead/cipher0/internal/vault"
 )
 
@@ -75,6 +76,13 @@ type TOTPTickMsg struct {
 // AutoLockMsg is sent when auto-lock timer expires
 type AutoLockMsg struct{}
 
+// AutoLockWarningMsg is sent when the auto-lock timer is close enough to
+// firing that the user should be warned, so they can act before losing
+// unsaved work.
+type AutoLockWarningMsg struct {
+	Remaining time.Duration
+}
+
 // ScreenChangeMsg requests a screen change
 type ScreenChangeMsg struct {
 	Screen Screen
@@ -151,9 +159,72 @@ func ClearNotificationAfter(d time.Duration) tea.Cmd {
 	})
 }
 
+// RevealExpiredMsg is sent when a momentary/flash password reveal should
+// re-mask. Generation guards against a stale timer re-masking a reveal that
+// started after it was scheduled.
+type RevealExpiredMsg struct {
+	Generation int
+}
+
+// RevealExpireAfter creates a command that sends RevealExpiredMsg for the
+// given generation after d.
+func RevealExpireAfter(d time.Duration, generation int) tea.Cmd {
+	return tea.Tick(d, func(t time.Time) tea.Msg {
+		return RevealExpiredMsg{Generation: generation}
+	})
+}
+
+// RevealAllExpiredMsg is sent when a reveal-all session should end and
+// every password should re-mask. Generation guards against a stale timer
+// re-masking a reveal-all session started after it was scheduled.
+type RevealAllExpiredMsg struct {
+	Generation int
+}
+
+// RevealAllExpireAfter creates a command that sends RevealAllExpiredMsg for
+// the given generation after d.
+func RevealAllExpireAfter(d time.Duration, generation int) tea.Cmd {
+	return tea.Tick(d, func(t time.Time) tea.Msg {
+		return RevealAllExpiredMsg{Generation: generation}
+	})
+}
+
+// FieldRevealExpiredMsg is sent when a revealed custom field should
+// re-mask. Generation guards against a stale timer re-masking a reveal of
+// that same field index that started after it was scheduled.
+type FieldRevealExpiredMsg struct {
+	FieldIndex int
+	Generation int
+}
+
+// FieldRevealExpireAfter creates a command that sends FieldRevealExpiredMsg
+// for the given field index and generation after d.
+func FieldRevealExpireAfter(d time.Duration, fieldIndex, generation int) tea.Cmd {
+	return tea.Tick(d, func(t time.Time) tea.Msg {
+		return FieldRevealExpiredMsg{FieldIndex: fieldIndex, Generation: generation}
+	})
+}
+
 // TOTPTick creates a command for TOTP timer ticks
 func TOTPTick() tea.Cmd {
 	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
 		return TOTPTickMsg{Time: t}
 	})
 }
+
+// AutoLockWarningThreshold is how long before auto-lock fires that the
+// warning banner starts showing.
+const AutoLockWarningThreshold = 10 * time.Second
+
+// AutoLockWarningTick polls timer's remaining time once a second and reports
+// it via AutoLockWarningMsg. The caller decides whether remaining is low
+// enough to show a warning (see AutoLockWarningThreshold) and whether to
+// keep polling.
+func AutoLockWarningTick(timer *utils.AutoLockTimer) tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		if timer == nil || !timer.IsActive() {
+			return nil
+		}
+		return AutoLockWarningMsg{Remaining: timer.Remaining()}
+	})
+}
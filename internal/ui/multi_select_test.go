@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func TestToggleSelectAddsAndRemovesAnID(t *testing.T) {
+	e1 := vault.NewEntry("One")
+	e2 := vault.NewEntry("Two")
+	m := newTestMainModel(t, "master-password-1", e1, e2)
+
+	m.ToggleSelect(e1.ID)
+	if !m.IsSelected(e1.ID) {
+		t.Fatal("Expected the entry to be selected after ToggleSelect")
+	}
+	if m.IsSelected(e2.ID) {
+		t.Error("Expected the other entry to remain unselected")
+	}
+
+	m.ToggleSelect(e1.ID)
+	if m.IsSelected(e1.ID) {
+		t.Error("Expected a second ToggleSelect to unselect the entry")
+	}
+}
+
+func TestSelectedIDsReflectsToggles(t *testing.T) {
+	e1 := vault.NewEntry("One")
+	e2 := vault.NewEntry("Two")
+	m := newTestMainModel(t, "master-password-1", e1, e2)
+
+	m.ToggleSelect(e1.ID)
+	m.ToggleSelect(e2.ID)
+
+	got := m.SelectedIDs()
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 selected IDs, got %d: %v", len(got), got)
+	}
+	want := map[string]bool{e1.ID: true, e2.ID: true}
+	for _, id := range got {
+		if !want[id] {
+			t.Errorf("Unexpected ID in SelectedIDs: %q", id)
+		}
+	}
+}
+
+func TestClearSelectionUnchecksEverything(t *testing.T) {
+	e1 := vault.NewEntry("One")
+	m := newTestMainModel(t, "master-password-1", e1)
+
+	m.ToggleSelect(e1.ID)
+	m.ClearSelection()
+
+	if m.IsSelected(e1.ID) {
+		t.Error("Expected ClearSelection to unselect every entry")
+	}
+	if len(m.SelectedIDs()) != 0 {
+		t.Errorf("Expected no selected IDs after ClearSelection, got %v", m.SelectedIDs())
+	}
+}
@@ -0,0 +1,125 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import "github.com/batterdaysahead/cipher0/internal/vault"
+
+// PaginatedList tracks a window of visible items over a (possibly large or
+// search-filtered) entry list, so rendering only ever touches the current
+// page instead of the whole list.
+type PaginatedList struct {
+	items       vault.EntryList
+	pageSize    int
+	currentPage int
+	selectedIdx int
+}
+
+// NewPaginatedList creates a list over items with the given page size.
+func NewPaginatedList(items vault.EntryList, pageSize int) *PaginatedList {
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	return &PaginatedList{items: items, pageSize: pageSize}
+}
+
+// SetItems replaces the underlying items (e.g. a new search-result subset),
+// resetting the page and selection to the start.
+func (l *PaginatedList) SetItems(items vault.EntryList) {
+	l.items = items
+	l.currentPage = 0
+	l.selectedIdx = 0
+}
+
+// TotalPages returns the number of pages, at least 1 even when empty.
+func (l *PaginatedList) TotalPages() int {
+	if len(l.items) == 0 {
+		return 1
+	}
+	return (len(l.items) + l.pageSize - 1) / l.pageSize
+}
+
+// PageStart returns the index of the first item on the current page.
+func (l *PaginatedList) PageStart() int {
+	return l.currentPage * l.pageSize
+}
+
+// PageEnd returns the index (exclusive) of the last item on the current page.
+func (l *PaginatedList) PageEnd() int {
+	end := l.PageStart() + l.pageSize
+	if end > len(l.items) {
+		end = len(l.items)
+	}
+	return end
+}
+
+// Visible returns only the items on the current page.
+func (l *PaginatedList) Visible() vault.EntryList {
+	return l.items[l.PageStart():l.PageEnd()]
+}
+
+// Selected returns the currently selected entry, or nil if the list is empty.
+func (l *PaginatedList) Selected() *vault.Entry {
+	if l.selectedIdx < 0 || l.selectedIdx >= len(l.items) {
+		return nil
+	}
+	return l.items[l.selectedIdx]
+}
+
+// SelectedIndex returns the index of the selected entry within the full list.
+func (l *PaginatedList) SelectedIndex() int {
+	return l.selectedIdx
+}
+
+// Next moves the selection down by one, advancing to the next page when it
+// crosses the current page boundary. It stops at the last item.
+func (l *PaginatedList) Next() {
+	if l.selectedIdx >= len(l.items)-1 {
+		return
+	}
+	l.selectedIdx++
+	if l.selectedIdx >= l.PageEnd() {
+		l.currentPage++
+	}
+}
+
+// Prev moves the selection up by one, returning to the previous page when it
+// crosses the current page boundary. It stops at the first item.
+func (l *PaginatedList) Prev() {
+	if l.selectedIdx <= 0 {
+		return
+	}
+	l.selectedIdx--
+	if l.selectedIdx < l.PageStart() {
+		l.currentPage--
+	}
+}
+
+// PageDown jumps to the start of the next page, if any.
+func (l *PaginatedList) PageDown() {
+	if l.currentPage >= l.TotalPages()-1 {
+		return
+	}
+	l.currentPage++
+	l.selectedIdx = l.PageStart()
+}
+
+// PageUp jumps to the start of the previous page, if any.
+func (l *PaginatedList) PageUp() {
+	if l.currentPage <= 0 {
+		return
+	}
+	l.currentPage--
+	l.selectedIdx = l.PageStart()
+}
+
+// JumpToLetter selects the next entry whose title starts with prefix,
+// wrapping around the full list, and pages to show it. Returns false if no
+// entry matches.
+func (l *PaginatedList) JumpToLetter(prefix string) bool {
+	idx := l.items.FirstIndexWithPrefix(prefix, l.selectedIdx)
+	if idx < 0 {
+		return false
+	}
+	l.selectedIdx = idx
+	l.currentPage = idx / l.pageSize
+	return true
+}
@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func entriesNamed(titles ...string) vault.EntryList {
+	items := make(vault.EntryList, len(titles))
+	for i, title := range titles {
+		items[i] = vault.NewEntry(title)
+	}
+	return items
+}
+
+func TestPaginatedListVisibleOnlyReturnsCurrentPage(t *testing.T) {
+	l := NewPaginatedList(entriesNamed("a", "b", "c", "d", "e"), 2)
+
+	visible := l.Visible()
+	if len(visible) != 2 {
+		t.Fatalf("Expected 2 visible items on the first page, got %d", len(visible))
+	}
+	if visible[0].Title != "a" || visible[1].Title != "b" {
+		t.Errorf("Expected the first page to be [a b], got [%s %s]", visible[0].Title, visible[1].Title)
+	}
+
+	l.PageDown()
+	visible = l.Visible()
+	if len(visible) != 2 || visible[0].Title != "c" || visible[1].Title != "d" {
+		t.Errorf("Expected the second page to be [c d], got %v", titlesOf(visible))
+	}
+
+	l.PageDown()
+	visible = l.Visible()
+	if len(visible) != 1 || visible[0].Title != "e" {
+		t.Errorf("Expected the last page to contain only [e], got %v", titlesOf(visible))
+	}
+}
+
+func titlesOf(items vault.EntryList) []string {
+	titles := make([]string, len(items))
+	for i, e := range items {
+		titles[i] = e.Title
+	}
+	return titles
+}
+
+func TestPaginatedListNextStopsAtLastItem(t *testing.T) {
+	l := NewPaginatedList(entriesNamed("a", "b", "c"), 2)
+
+	for i := 0; i < 10; i++ {
+		l.Next()
+	}
+
+	if l.SelectedIndex() != 2 {
+		t.Errorf("Expected Next to stop at the last index (2), got %d", l.SelectedIndex())
+	}
+	if l.Selected().Title != "c" {
+		t.Errorf("Expected the last item to be selected, got %q", l.Selected().Title)
+	}
+}
+
+func TestPaginatedListPrevStopsAtFirstItem(t *testing.T) {
+	l := NewPaginatedList(entriesNamed("a", "b", "c"), 2)
+	l.Next()
+	l.Next()
+
+	for i := 0; i < 10; i++ {
+		l.Prev()
+	}
+
+	if l.SelectedIndex() != 0 {
+		t.Errorf("Expected Prev to stop at index 0, got %d", l.SelectedIndex())
+	}
+}
+
+func TestPaginatedListNextAdvancesPageAtBoundary(t *testing.T) {
+	l := NewPaginatedList(entriesNamed("a", "b", "c", "d"), 2)
+
+	l.Next()
+	if l.PageStart() != 0 {
+		t.Fatalf("Expected to still be on the first page, got page start %d", l.PageStart())
+	}
+
+	l.Next()
+	if l.PageStart() != 2 {
+		t.Errorf("Expected crossing the page boundary to advance the page, got page start %d", l.PageStart())
+	}
+}
+
+func TestPaginatedListPageUpAndDownRespectBounds(t *testing.T) {
+	l := NewPaginatedList(entriesNamed("a", "b", "c", "d", "e"), 2)
+
+	l.PageUp()
+	if l.PageStart() != 0 {
+		t.Errorf("Expected PageUp to be a no-op at the first page, got page start %d", l.PageStart())
+	}
+
+	for i := 0; i < 10; i++ {
+		l.PageDown()
+	}
+	if l.currentPage != l.TotalPages()-1 {
+		t.Errorf("Expected PageDown to stop at the last page, got page %d of %d", l.currentPage, l.TotalPages())
+	}
+}
+
+func TestPaginatedListJumpToLetterWrapsAroundTheFullList(t *testing.T) {
+	l := NewPaginatedList(entriesNamed("Apple", "Banana", "Avocado", "Cherry"), 2)
+	l.selectedIdx = 0 // starts on "Apple"
+
+	if !l.JumpToLetter("a") {
+		t.Fatal("Expected JumpToLetter to find a match")
+	}
+	if l.Selected().Title != "Avocado" {
+		t.Errorf("Expected the next 'a' match after Apple to be Avocado, got %q", l.Selected().Title)
+	}
+
+	if !l.JumpToLetter("a") {
+		t.Fatal("Expected JumpToLetter to find a match on the second call")
+	}
+	if l.Selected().Title != "Apple" {
+		t.Errorf("Expected JumpToLetter to wrap back around to Apple, got %q", l.Selected().Title)
+	}
+}
+
+func TestPaginatedListJumpToLetterReturnsFalseWhenNoMatch(t *testing.T) {
+	l := NewPaginatedList(entriesNamed("Apple", "Banana"), 2)
+
+	if l.JumpToLetter("z") {
+		t.Error("Expected JumpToLetter to return false when nothing matches")
+	}
+}
+
+func TestPaginatedListSetItemsResetsPageAndSelection(t *testing.T) {
+	l := NewPaginatedList(entriesNamed("a", "b", "c", "d"), 2)
+	l.Next()
+	l.PageDown()
+
+	l.SetItems(entriesNamed("x", "y"))
+
+	if l.SelectedIndex() != 0 {
+		t.Errorf("Expected SetItems to reset selection to 0, got %d", l.SelectedIndex())
+	}
+	if l.PageStart() != 0 {
+		t.Errorf("Expected SetItems to reset the page, got page start %d", l.PageStart())
+	}
+}
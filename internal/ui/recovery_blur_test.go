@@ -0,0 +1,51 @@
+package ui
+
+import "testing"
+
+func TestRecoveryDisplayStartsBlurredWithNoRealWordsVisible(t *testing.T) {
+	m := NewRecoveryDisplayModel("apple banana cherry")
+
+	if !m.IsBlurred() {
+		t.Fatal("Expected a new RecoveryDisplayModel to start blurred")
+	}
+	for _, w := range m.displayWords() {
+		if w != blurredWordPlaceholder {
+			t.Errorf("Expected every word to be masked, got %q", w)
+		}
+	}
+}
+
+func TestRevealNextRevealsOneWordAtATime(t *testing.T) {
+	m := NewRecoveryDisplayModel("apple banana cherry")
+
+	m.RevealNext()
+	words := m.displayWords()
+	if words[0] != "apple" {
+		t.Errorf("Expected the first word to be revealed, got %q", words[0])
+	}
+	if words[1] != blurredWordPlaceholder || words[2] != blurredWordPlaceholder {
+		t.Errorf("Expected the remaining words to stay masked, got %v", words)
+	}
+
+	m.RevealNext()
+	words = m.displayWords()
+	if words[1] != "banana" {
+		t.Errorf("Expected the second word to be revealed, got %q", words[1])
+	}
+}
+
+func TestRevealAllShowsEveryWord(t *testing.T) {
+	m := NewRecoveryDisplayModel("apple banana cherry")
+
+	m.RevealAll()
+
+	if m.IsBlurred() {
+		t.Error("Expected RevealAll to clear the blurred state")
+	}
+	words := m.displayWords()
+	for i, w := range words {
+		if w != m.words[i] {
+			t.Errorf("Expected word %d to be %q, got %q", i, m.words[i], w)
+		}
+	}
+}
@@ -6,16 +6,126 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
 )
 
+// defaultRecoveryColumns is the column count used when none is set.
+const defaultRecoveryColumns = 2
+
+// blurredWordPlaceholder replaces a not-yet-revealed word in the grid, so a
+// screen recording doesn't accidentally capture the phrase.
+const blurredWordPlaceholder = "•••••"
+
 type RecoveryDisplayModel struct {
 	phrase    string
 	words     []string
 	confirmed bool
+	columns   int
+
+	// blurred hides words behind blurredWordPlaceholder until revealed.
+	// Defaults to true: the phrase starts hidden.
+	blurred       bool
+	revealedWords map[int]bool
+
+	// lineLayout shows the phrase as a single space-joined line instead of
+	// the numbered grid, for users who prefer one selectable line to copy.
+	lineLayout bool
+
+	clipboard *utils.ClipboardManager
+}
+
+// SetClipboardManager wires a clipboard manager so the copy action has
+// somewhere to put the phrase.
+func (m *RecoveryDisplayModel) SetClipboardManager(cm *utils.ClipboardManager) {
+	m.clipboard = cm
 }
 
 func NewRecoveryDisplayModel(phrase string) *RecoveryDisplayModel {
-	return &RecoveryDisplayModel{phrase: phrase, words: strings.Fields(phrase)}
+	return &RecoveryDisplayModel{
+		phrase:        phrase,
+		words:         strings.Fields(phrase),
+		columns:       defaultRecoveryColumns,
+		blurred:       true,
+		revealedWords: make(map[int]bool),
+	}
+}
+
+// RevealNext reveals the next still-blurred word, in index order. No-op once
+// every word is revealed.
+func (m *RecoveryDisplayModel) RevealNext() {
+	for i := range m.words {
+		if !m.revealedWords[i] {
+			m.revealedWords[i] = true
+			return
+		}
+	}
+}
+
+// RevealAll turns off blurring entirely, showing every word at once.
+func (m *RecoveryDisplayModel) RevealAll() {
+	m.blurred = false
+}
+
+// IsConfirmed reports whether the user has pressed 'c' to confirm they've
+// saved the recovery phrase.
+func (m *RecoveryDisplayModel) IsConfirmed() bool {
+	return m.confirmed
+}
+
+// IsBlurred reports whether any word is still hidden.
+func (m *RecoveryDisplayModel) IsBlurred() bool {
+	return m.blurred
+}
+
+// displayWords returns m.words with still-blurred entries replaced by
+// blurredWordPlaceholder.
+func (m *RecoveryDisplayModel) displayWords() []string {
+	if !m.blurred {
+		return m.words
+	}
+	display := make([]string, len(m.words))
+	for i, w := range m.words {
+		if m.revealedWords[i] {
+			display[i] = w
+		} else {
+			display[i] = blurredWordPlaceholder
+		}
+	}
+	return display
+}
+
+// ToggleLayout switches between the numbered word grid and a single
+// space-joined line.
+func (m *RecoveryDisplayModel) ToggleLayout() {
+	m.lineLayout = !m.lineLayout
+}
+
+// IsLineLayout reports whether the plain line layout is active, rather than
+// the numbered grid.
+func (m *RecoveryDisplayModel) IsLineLayout() bool {
+	return m.lineLayout
+}
+
+// copyPhrase copies the full phrase to the clipboard. It's only reachable
+// after the user confirms via the app's confirmation dialog, given how
+// sensitive the phrase is.
+func (m *RecoveryDisplayModel) copyPhrase() tea.Cmd {
+	if m.clipboard == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		_ = m.clipboard.Copy(m.phrase)
+		return ClipboardCopiedMsg{Label: "Recovery phrase"}
+	}
+}
+
+// SetColumns overrides the number of columns the word grid is rendered
+// with. Values less than 1 are ignored.
+func (m *RecoveryDisplayModel) SetColumns(columns int) {
+	if columns > 0 {
+		m.columns = columns
+	}
 }
 
 func (m *RecoveryDisplayModel) Init() tea.Cmd { return nil }
@@ -26,6 +136,14 @@ func (m *RecoveryDisplayModel) Update(msg tea.Msg) (*RecoveryDisplayModel, tea.C
 		switch msg.String() {
 		case "c", "C":
 			m.confirmed = true
+		case "r":
+			m.RevealNext()
+		case "R":
+			m.RevealAll()
+		case "g", "G":
+			m.ToggleLayout()
+		case "y":
+			return m, RequestConfirmation("Copy Recovery Phrase", "Copy the full recovery phrase to the clipboard?", m.copyPhrase(), nil)
 		case "enter":
 			if m.confirmed {
 				return m, NavigateTo(ScreenMain, nil)
@@ -37,6 +155,36 @@ func (m *RecoveryDisplayModel) Update(msg tea.Msg) (*RecoveryDisplayModel, tea.C
 	return m, nil
 }
 
+// renderWordGrid lays words out column-major across the given number of
+// columns (e.g. for 2 columns: word 1 above word 2 in the left column, word
+// N/2+1 starting the right column), padding the last row if the word count
+// doesn't divide evenly. Falls back to defaultRecoveryColumns if columns < 1.
+func renderWordGrid(words []string, columns int) string {
+	if columns < 1 {
+		columns = defaultRecoveryColumns
+	}
+	if len(words) == 0 {
+		return ""
+	}
+
+	rows := (len(words) + columns - 1) / columns
+
+	var b strings.Builder
+	for r := 0; r < rows; r++ {
+		var cells []string
+		for c := 0; c < columns; c++ {
+			idx := c*rows + r
+			if idx >= len(words) {
+				continue
+			}
+			cells = append(cells, DimStyle.Render(fmt.Sprintf("%2d.", idx+1))+" "+BaseStyle.Render(fmt.Sprintf("%-12s", words[idx])))
+		}
+		b.WriteString(strings.Join(cells, "    "))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
 func (m *RecoveryDisplayModel) View(width, height int) string {
 	contentWidth := 50
 
@@ -54,16 +202,20 @@ func (m *RecoveryDisplayModel) View(width, height int) string {
 	b.WriteString(WarningStyle.Render("⚠ SAVE THESE WORDS!"))
 	b.WriteString("\n\n")
 
-	// Words grid
-	if len(m.words) >= 12 {
-		for i := range 6 {
-			left := DimStyle.Render(fmt.Sprintf(" %2d.", i+1)) + " " + BaseStyle.Render(fmt.Sprintf("%-12s", m.words[i]))
-			right := DimStyle.Render(fmt.Sprintf("%2d.", i+7)) + " " + BaseStyle.Render(fmt.Sprintf("%-12s", m.words[i+6]))
-			b.WriteString(left + "    " + right + "\n")
-		}
+	// Words, as a numbered grid or a single selectable line
+	if m.lineLayout {
+		b.WriteString("    " + BaseStyle.Render(strings.Join(m.displayWords(), " ")))
+		b.WriteString("\n")
+	} else {
+		b.WriteString(renderWordGrid(m.displayWords(), m.columns))
 	}
 	b.WriteString("\n")
 
+	if m.blurred {
+		b.WriteString(DimStyle.Render("    Press 'r' to reveal the next word, 'R' to reveal all."))
+		b.WriteString("\n\n")
+	}
+
 	// Info
 	b.WriteString(DimStyle.Render("    This is the ONLY way to recover your vault."))
 	b.WriteString("\n")
@@ -81,6 +233,8 @@ func (m *RecoveryDisplayModel) View(width, height int) string {
 	b.WriteString("\n\n")
 	b.WriteString(RenderBottomBar([][]string{
 		{"Confirm", "c"},
+		{"Layout", "g"},
+		{"Copy", "y"},
 		{"Continue", "enter"},
 	}, contentWidth))
 
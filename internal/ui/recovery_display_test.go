@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// wordsOfCount returns n distinct, fixed-width placeholder words, so no word
+// is ever a substring of another (unlike "word1" vs "word10").
+func wordsOfCount(n int) []string {
+	words := make([]string, n)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%02d", i+1)
+	}
+	return words
+}
+
+func assertGridContainsEveryWordOnce(t *testing.T, words []string, columns int) {
+	t.Helper()
+	grid := renderWordGrid(words, columns)
+	for i, w := range words {
+		want := fmt.Sprintf("%2d.", i+1)
+		count := strings.Count(grid, w)
+		if count != 1 {
+			t.Errorf("Expected %q to appear exactly once in the grid, appeared %d times", w, count)
+		}
+		if !strings.Contains(grid, want) {
+			t.Errorf("Expected grid to number word %d as %q", i+1, want)
+		}
+	}
+}
+
+func TestRenderWordGridWithTwelveWords(t *testing.T) {
+	assertGridContainsEveryWordOnce(t, wordsOfCount(12), 2)
+}
+
+func TestRenderWordGridWithTwentyFourWords(t *testing.T) {
+	assertGridContainsEveryWordOnce(t, wordsOfCount(24), 2)
+}
+
+func TestRenderWordGridPadsUnevenLastRow(t *testing.T) {
+	words := wordsOfCount(13)
+	assertGridContainsEveryWordOnce(t, words, 4)
+
+	grid := renderWordGrid(words, 4)
+	lines := strings.Split(strings.TrimRight(grid, "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected 13 words across 4 columns to produce 4 rows, got %d", len(lines))
+	}
+}
+
+func TestRenderWordGridFallsBackToDefaultColumnsWhenInvalid(t *testing.T) {
+	words := wordsOfCount(12)
+	withZero := renderWordGrid(words, 0)
+	withDefault := renderWordGrid(words, defaultRecoveryColumns)
+	if withZero != withDefault {
+		t.Errorf("Expected columns < 1 to fall back to defaultRecoveryColumns")
+	}
+}
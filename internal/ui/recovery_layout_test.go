@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestToggleLayoutSwitchesBetweenGridAndLine(t *testing.T) {
+	m := NewRecoveryDisplayModel("apple banana cherry")
+	m.RevealAll()
+
+	if m.IsLineLayout() {
+		t.Fatal("Expected the grid layout to be the default")
+	}
+	gridView := m.View(80, 24)
+
+	m.ToggleLayout()
+	if !m.IsLineLayout() {
+		t.Fatal("Expected ToggleLayout to switch to the line layout")
+	}
+	lineView := m.View(80, 24)
+
+	if gridView == lineView {
+		t.Error("Expected the grid and line layouts to render differently")
+	}
+	if !strings.Contains(lineView, "apple banana cherry") {
+		t.Errorf("Expected the line layout to render the phrase as one joined line, got %q", lineView)
+	}
+}
+
+func TestCopyPhraseRequiresConfirmation(t *testing.T) {
+	m := NewRecoveryDisplayModel("apple banana cherry")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd == nil {
+		t.Fatal("Expected pressing 'y' to return a command")
+	}
+
+	msg := cmd()
+	confirm, ok := msg.(ConfirmationRequestMsg)
+	if !ok {
+		t.Fatalf("Expected a ConfirmationRequestMsg, got %T", msg)
+	}
+	if confirm.OnYes == nil {
+		t.Error("Expected the confirmation to carry the copy action for a 'yes' answer")
+	}
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func TestRevealAllRequiresVerificationBeforeActivating(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	if !m.reauthMode || !m.reauthForRevealAll {
+		t.Fatal("Expected 'R' to require re-authentication before revealing all")
+	}
+	if m.revealAll {
+		t.Fatal("Expected revealAll to stay off until re-auth succeeds")
+	}
+
+	m.reauthInput.SetValue("wrong-password")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.revealAll {
+		t.Error("Expected an incorrect password not to activate reveal-all")
+	}
+
+	m.reauthMode = true
+	m.reauthForRevealAll = true
+	m.reauthInput.SetValue("master-password-1")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.revealAll {
+		t.Error("Expected a correct password to activate reveal-all")
+	}
+}
+
+func TestRevealAllReMasksAfterTheTimeoutMessage(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+
+	cmd := m.startRevealAll()
+	if !m.revealAll {
+		t.Fatal("Expected startRevealAll to activate reveal-all immediately")
+	}
+	if cmd == nil {
+		t.Fatal("Expected startRevealAll to schedule an expiry")
+	}
+
+	msg := cmd()
+	expired, ok := msg.(RevealAllExpiredMsg)
+	if !ok {
+		t.Fatalf("Expected a RevealAllExpiredMsg, got %T", msg)
+	}
+
+	m, _ = m.Update(expired)
+	if m.revealAll {
+		t.Error("Expected the timeout message to re-mask reveal-all")
+	}
+}
+
+func TestRevealAllExpiredMsgIgnoredIfGenerationIsStale(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+
+	cmd := m.startRevealAll()
+	staleMsg := cmd().(RevealAllExpiredMsg)
+
+	// Toggling reveal-all off and back on bumps the generation, making the
+	// first timer's expiry message stale.
+	m.revealAll = false
+	m.startRevealAll()
+
+	m, _ = m.Update(staleMsg)
+	if !m.revealAll {
+		t.Error("Expected a stale RevealAllExpiredMsg not to re-mask a newer reveal-all session")
+	}
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func TestRevealFlashModeReMasksAfterExpiry(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+	m.SetRevealMode(RevealFlash)
+
+	cmd := m.reveal()
+	if !m.passwordRevealed {
+		t.Fatal("Expected reveal() to reveal the password immediately")
+	}
+	if cmd == nil {
+		t.Fatal("Expected Flash mode to schedule a re-mask")
+	}
+
+	msg := cmd()
+	expired, ok := msg.(RevealExpiredMsg)
+	if !ok {
+		t.Fatalf("Expected a RevealExpiredMsg, got %T", msg)
+	}
+
+	m, _ = m.Update(expired)
+	if m.passwordRevealed {
+		t.Error("Expected the password to be re-masked once the Flash timer fires")
+	}
+}
+
+func TestRevealToggleModeStaysRevealedUntilToggled(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+	m.SetRevealMode(RevealToggle)
+
+	cmd := m.reveal()
+	if !m.passwordRevealed {
+		t.Fatal("Expected reveal() to reveal the password immediately")
+	}
+	if cmd != nil {
+		t.Error("Expected Toggle mode not to schedule an automatic re-mask")
+	}
+}
+
+func TestRevealExpiredMsgIgnoredIfGenerationIsStale(t *testing.T) {
+	e := vault.NewEntry("Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+	m.SetRevealMode(RevealFlash)
+
+	cmd := m.reveal()
+	staleMsg := cmd().(RevealExpiredMsg)
+
+	// A second reveal bumps the generation, making the first timer's message stale.
+	m.passwordRevealed = false
+	m.reveal()
+
+	m, _ = m.Update(staleMsg)
+	if !m.passwordRevealed {
+		t.Error("Expected a stale RevealExpiredMsg not to re-mask a newer reveal")
+	}
+}
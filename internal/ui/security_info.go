@@ -0,0 +1,24 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+// FormatKDFInfo renders a key derivation config for a settings/audit row,
+// e.g. "Argon2id, 256MB, 5 iters, 4 threads".
+func FormatKDFInfo(cfg vault.KDFConfig) string {
+	algorithm := strings.ToLower(cfg.Algorithm)
+	switch algorithm {
+	case "argon2id":
+		algorithm = "Argon2id"
+	}
+
+	memoryMB := cfg.Params.Memory / 1024
+
+	return fmt.Sprintf("%s, %dMB, %d iters, %d threads",
+		algorithm, memoryMB, cfg.Params.Iterations, cfg.Params.Parallelism)
+}
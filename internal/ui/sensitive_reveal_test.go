@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
+	"github.com/batterdaysahead/cipher0/internal/vault"
+)
+
+func newTestMainModel(t *testing.T, password string, entries ...*vault.Entry) *MainModel {
+	t.Helper()
+	v, _, err := vault.Create(filepath.Join(t.TempDir(), "test.vault"), password)
+	if err != nil {
+		t.Fatalf("vault.Create failed: %v", err)
+	}
+	for _, e := range entries {
+		if err := v.AddEntry(e); err != nil {
+			t.Fatalf("AddEntry failed: %v", err)
+		}
+	}
+	m := NewMainModel(v, utils.NewClipboardManager(0))
+	m.refreshEntries()
+	return m
+}
+
+func pressKey(m *MainModel, msg tea.KeyMsg) *MainModel {
+	m, _ = m.Update(msg)
+	return m
+}
+
+func TestRevealOfNonSensitiveEntryRequiresNoReauth(t *testing.T) {
+	e := vault.NewEntry("Normal Entry")
+	m := newTestMainModel(t, "master-password-1", e)
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+
+	if m.reauthMode {
+		t.Fatal("Expected no reauth prompt for a non-sensitive entry")
+	}
+	if !m.passwordRevealed {
+		t.Fatal("Expected the non-sensitive entry to reveal immediately")
+	}
+}
+
+func TestRevealOfSensitiveEntryRequiresSuccessfulVerify(t *testing.T) {
+	e := vault.NewEntry("Root Credentials")
+	e.Sensitive = true
+	m := newTestMainModel(t, "master-password-1", e)
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if !m.reauthMode {
+		t.Fatal("Expected a sensitive entry to require re-authentication before reveal")
+	}
+	if m.passwordRevealed {
+		t.Fatal("Expected the entry not to be revealed before re-auth completes")
+	}
+
+	m.reauthInput.SetValue("wrong-password")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.passwordRevealed {
+		t.Fatal("Expected a wrong master password not to reveal the entry")
+	}
+	if m.reauthMode {
+		t.Fatal("Expected the reauth prompt to close after a failed attempt")
+	}
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if !m.reauthMode {
+		t.Fatal("Expected pressing reveal again to re-prompt for re-authentication")
+	}
+	m.reauthInput.SetValue("master-password-1")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !m.passwordRevealed {
+		t.Fatal("Expected the correct master password to reveal the sensitive entry")
+	}
+}
@@ -22,6 +22,7 @@ const (
 	SettingsModeVerifyPhraseWarning  // Warning confirmation step
 	SettingsModeVerifyPhrasePassword // Password verification step
 	SettingsModeVerifyPhrase         // Phrase input step
+	SettingsModeSetDuressPassword    // Duress password setup step
 )
 
 type SettingsModel struct {
@@ -105,7 +106,7 @@ func (m *SettingsModel) initVerifyPhraseInputs() {
 	m.phraseFocusIdx = 0
 }
 
-var settingsItems = []string{"Auto-lock timeout", "Clipboard timeout", "Auto-backup", "Backup reminder", "Password length", "Change Password", "Verify Recovery Phrase", "Back"}
+var settingsItems = []string{"Auto-lock timeout", "Clipboard timeout", "Auto-backup", "Backup reminder", "Password length", "Change Password", "Set Duress Password", "Verify Recovery Phrase", "Back"}
 
 func (m *SettingsModel) Init() tea.Cmd {
 	// If password setup is required, go directly to change password mode
@@ -129,6 +130,8 @@ func (m *SettingsModel) Update(msg tea.Msg) (*SettingsModel, tea.Cmd) {
 		return m.updateVerifyPhrasePassword(msg)
 	case SettingsModeVerifyPhrase:
 		return m.updateVerifyPhrase(msg)
+	case SettingsModeSetDuressPassword:
+		return m.updateSetDuressPassword(msg)
 	default:
 		return m.updateList(msg)
 	}
@@ -164,11 +167,17 @@ func (m *SettingsModel) updateList(msg tea.Msg) (*SettingsModel, tea.Cmd) {
 				m.currentPassword.Focus()
 				m.passwordFocus = 0
 				return m, textinput.Blink
-			case 6: // Verify Recovery Phrase
+			case 6: // Set Duress Password
+				m.mode = SettingsModeSetDuressPassword
+				m.initPasswordInputs()
+				m.currentPassword.Focus()
+				m.passwordFocus = 0
+				return m, textinput.Blink
+			case 7: // Verify Recovery Phrase
 				m.mode = SettingsModeVerifyPhraseWarning
 				m.message = ""
 				return m, nil
-			case 7: // Back
+			case 8: // Back
 				return m, NavigateTo(ScreenMain, nil)
 			}
 		case "esc", "q":
@@ -300,11 +309,113 @@ func (m *SettingsModel) changePassword() (*SettingsModel, tea.Cmd) {
 	m.requirePasswordSetup = false // Clear flag after successful setup
 	m.mode = SettingsModeList
 	m.message = "Password set successfully"
+	if current != "" && utils.IsTrivialVariation(current, newPwd) {
+		m.message = "Password set, but it's very similar to your old one"
+	}
 	m.messageType = "success"
 	// Navigate to main screen after successful password setup
 	return m, NavigateTo(ScreenMain, nil)
 }
 
+// updateSetDuressPassword handles input for the duress password setup step.
+// It reuses the same three password fields and tab-cycling behavior as
+// updateChangePassword: current password verifies the user, new/confirm set
+// the duress password.
+func (m *SettingsModel) updateSetDuressPassword(msg tea.Msg) (*SettingsModel, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.Type != tea.KeyEnter {
+			m.message = ""
+			m.passwordErrorFields = make(map[int]bool)
+		}
+
+		switch msg.Type {
+		case tea.KeyTab, tea.KeyDown:
+			m.blurPasswordInputs()
+			m.passwordFocus = (m.passwordFocus + 1) % 3
+			m.focusPasswordInput()
+			return m, nil
+		case tea.KeyShiftTab, tea.KeyUp:
+			m.blurPasswordInputs()
+			m.passwordFocus = (m.passwordFocus + 2) % 3
+			m.focusPasswordInput()
+			return m, nil
+		case tea.KeyEnter:
+			return m.setDuressPassword()
+		case tea.KeyEsc:
+			m.mode = SettingsModeList
+			m.message = ""
+			return m, nil
+		}
+	}
+
+	switch m.passwordFocus {
+	case 0:
+		m.currentPassword, cmd = m.currentPassword.Update(msg)
+	case 1:
+		m.newPassword, cmd = m.newPassword.Update(msg)
+	case 2:
+		m.confirmPassword, cmd = m.confirmPassword.Update(msg)
+	}
+	return m, cmd
+}
+
+func (m *SettingsModel) setDuressPassword() (*SettingsModel, tea.Cmd) {
+	m.message = ""
+	m.passwordErrorFields = make(map[int]bool)
+
+	current := m.currentPassword.Value()
+	duress := m.newPassword.Value()
+	confirm := m.confirmPassword.Value()
+
+	if current == "" {
+		m.message = "Current password required"
+		m.messageType = "error"
+		m.passwordErrorFields[0] = true
+		return m, nil
+	}
+	if duress == "" {
+		m.message = "Duress password required"
+		m.messageType = "error"
+		m.passwordErrorFields[1] = true
+		return m, nil
+	}
+	if len(duress) < 8 {
+		m.message = "Minimum 8 characters"
+		m.messageType = "error"
+		m.passwordErrorFields[1] = true
+		return m, nil
+	}
+	if duress != confirm {
+		m.message = "Passwords don't match"
+		m.messageType = "error"
+		m.passwordErrorFields[2] = true
+		m.confirmPassword.Reset()
+		return m, nil
+	}
+	if duress == current {
+		m.message = "Duress password must differ from your master password"
+		m.messageType = "error"
+		m.passwordErrorFields[1] = true
+		return m, nil
+	}
+
+	if err := m.vault.SetDuressPassword(current, duress); err != nil {
+		m.message = "Current password is incorrect"
+		m.messageType = "error"
+		m.passwordErrorFields[0] = true
+		m.currentPassword.Reset()
+		return m, nil
+	}
+
+	m.mode = SettingsModeList
+	m.message = "Duress password set successfully"
+	m.messageType = "success"
+	return m, nil
+}
+
 // updateVerifyPhraseWarning handles the warning confirmation step.
 func (m *SettingsModel) updateVerifyPhraseWarning(msg tea.Msg) (*SettingsModel, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -504,6 +615,8 @@ func (m *SettingsModel) View(width, height int) string {
 		return m.viewVerifyPhrasePassword(width, height)
 	case SettingsModeVerifyPhrase:
 		return m.viewVerifyPhrase(width, height)
+	case SettingsModeSetDuressPassword:
+		return m.viewSetDuressPassword(width, height)
 	default:
 		return m.viewList(width, height)
 	}
@@ -541,6 +654,37 @@ func (m *SettingsModel) viewList(width, height int) string {
 		b.WriteString("\n")
 	}
 
+	// Read-only KDF detail row for auditing what protects this vault.
+	if m.vault != nil {
+		b.WriteString("\n")
+		b.WriteString(DimStyle.Render(fmt.Sprintf("%-22s", "Key derivation")))
+		b.WriteString("  " + ValueStyle.Render(FormatKDFInfo(*m.vault.KDFConfig())))
+		b.WriteString("\n")
+	}
+
+	// Vault overview: read-only summary stats over the current entries.
+	if m.vault != nil {
+		stats := m.vault.Entries().Stats()
+		b.WriteString("\n")
+		b.WriteString(RenderSectionHeader("VAULT OVERVIEW"))
+		b.WriteString("\n\n")
+		b.WriteString(DimStyle.Render(fmt.Sprintf("%-22s", "Entries")))
+		b.WriteString("  " + ValueStyle.Render(fmt.Sprintf("%d", stats.TotalEntries)))
+		b.WriteString("\n")
+		b.WriteString(DimStyle.Render(fmt.Sprintf("%-22s", "With TOTP")))
+		b.WriteString("  " + ValueStyle.Render(fmt.Sprintf("%d", stats.WithTOTP)))
+		b.WriteString("\n")
+		b.WriteString(DimStyle.Render(fmt.Sprintf("%-22s", "With URL")))
+		b.WriteString("  " + ValueStyle.Render(fmt.Sprintf("%d", stats.WithURL)))
+		b.WriteString("\n")
+		b.WriteString(DimStyle.Render(fmt.Sprintf("%-22s", "Unique tags")))
+		b.WriteString("  " + ValueStyle.Render(fmt.Sprintf("%d", stats.UniqueTags)))
+		b.WriteString("\n")
+		b.WriteString(DimStyle.Render(fmt.Sprintf("%-22s", "Avg password length")))
+		b.WriteString("  " + ValueStyle.Render(fmt.Sprintf("%.1f", stats.AveragePasswordLen)))
+		b.WriteString("\n")
+	}
+
 	// Message
 	if m.message != "" {
 		b.WriteString("\n")
@@ -658,6 +802,88 @@ func (m *SettingsModel) viewChangePassword(width, height int) string {
 	return centerContent(b.String(), width, height)
 }
 
+// viewSetDuressPassword shows the duress password setup screen.
+func (m *SettingsModel) viewSetDuressPassword(width, height int) string {
+	contentWidth := 50
+
+	var b strings.Builder
+
+	b.WriteString(RenderHeader("VAULT", "Duress Password", contentWidth))
+	b.WriteString("\n\n")
+	b.WriteString(RenderSectionHeader("SET DURESS PASSWORD"))
+	b.WriteString("\n\n")
+	b.WriteString(DimStyle.Render("Entering this password instead of your master"))
+	b.WriteString("\n")
+	b.WriteString(DimStyle.Render("password opens an empty decoy vault."))
+	b.WriteString("\n\n")
+
+	var label1 string
+	if m.passwordErrorFields[0] {
+		label1 = ErrorStyle.Render("Current Password")
+	} else if m.passwordFocus == 0 {
+		label1 = TitleStyle.Render("Current Password")
+	} else {
+		label1 = DimStyle.Render("Current Password")
+	}
+	b.WriteString(label1)
+	b.WriteString("\n")
+	b.WriteString("  " + m.currentPassword.View())
+	if m.passwordFocus == 0 {
+		b.WriteString(TitleStyle.Render("█"))
+	}
+	b.WriteString("\n\n")
+
+	var label2 string
+	if m.passwordErrorFields[1] {
+		label2 = ErrorStyle.Render("Duress Password")
+	} else if m.passwordFocus == 1 {
+		label2 = TitleStyle.Render("Duress Password")
+	} else {
+		label2 = DimStyle.Render("Duress Password")
+	}
+	b.WriteString(label2)
+	b.WriteString("\n")
+	b.WriteString("  " + m.newPassword.View())
+	if m.passwordFocus == 1 {
+		b.WriteString(TitleStyle.Render("█"))
+	}
+	b.WriteString("\n\n")
+
+	var label3 string
+	if m.passwordErrorFields[2] {
+		label3 = ErrorStyle.Render("Confirm Duress Password")
+	} else if m.passwordFocus == 2 {
+		label3 = TitleStyle.Render("Confirm Duress Password")
+	} else {
+		label3 = DimStyle.Render("Confirm Duress Password")
+	}
+	b.WriteString(label3)
+	b.WriteString("\n")
+	b.WriteString("  " + m.confirmPassword.View())
+	if m.passwordFocus == 2 {
+		b.WriteString(TitleStyle.Render("█"))
+	}
+	b.WriteString("\n")
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.messageType == "error" {
+			b.WriteString(ErrorStyle.Render(m.message))
+		} else {
+			b.WriteString(SuccessStyle.Render("✓ " + m.message))
+		}
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(RenderBottomBar([][]string{
+		{"Next", "tab"},
+		{"Save", "enter"},
+		{"Cancel", "esc"},
+	}, contentWidth))
+
+	return centerContent(b.String(), width, height)
+}
+
 // viewVerifyPhraseWarning shows the warning confirmation screen.
 func (m *SettingsModel) viewVerifyPhraseWarning(width, height int) string {
 	contentWidth := 60
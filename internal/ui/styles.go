@@ -177,6 +177,9 @@ var (
 
 	QuoteBarStyle  = lipgloss.NewStyle().Foreground(ColorDarkGray).SetString("│")
 	QuoteTextStyle = lipgloss.NewStyle().Foreground(ColorGray)
+
+	// HighlightStyle marks a search match within otherwise-default text.
+	HighlightStyle = lipgloss.NewStyle().Foreground(ColorAccent).Bold(true)
 )
 
 // Layout helpers - no separator lines
@@ -233,6 +236,30 @@ func RenderDetailRow(label, value string) string {
 	return LabelStyle.Render(paddedLabel) + ValueStyle.Render(value)
 }
 
+// entryColorPalette maps an Entry.Color key to its display color, for
+// visual grouping (e.g. work=blue, personal=green). Unknown/empty keys fall
+// back to ColorMuted in RenderColorDot rather than erroring, since a color
+// tag is cosmetic.
+var entryColorPalette = map[string]lipgloss.Color{
+	"blue":   ColorCyan,
+	"green":  ColorGreen,
+	"yellow": ColorYellow,
+	"red":    ColorRed,
+	"purple": ColorMagenta,
+	"gray":   ColorGray,
+}
+
+// RenderColorDot renders a single-character dot in the color palette entry
+// named by color (see entryColorPalette), or a neutral ColorMuted dot for an
+// empty or unrecognized color key.
+func RenderColorDot(color string) string {
+	c, ok := entryColorPalette[color]
+	if !ok {
+		c = ColorMuted
+	}
+	return lipgloss.NewStyle().Foreground(c).Render("●")
+}
+
 func RenderPasswordStrength(strength int) string {
 	labels := []string{"weak", "fair", "good", "strong", "excellent"}
 	styles := []lipgloss.Style{
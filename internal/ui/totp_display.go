@@ -0,0 +1,26 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import (
+	"fmt"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+// FormatTOTPLine renders the live code for secret, styled urgently when
+// fewer than 5 seconds remain in the current period. The raw secret is
+// never included in the output, so this is safe to show without a separate
+// reveal action.
+func FormatTOTPLine(secret string) (string, error) {
+	code, remaining, err := totp.GenerateCode(secret)
+	if err != nil {
+		return "", err
+	}
+
+	style := TOTPCodeStyle
+	if remaining <= 5 {
+		style = TOTPTimerUrgentStyle
+	}
+
+	return style.Render(totp.FormatCode(code)) + DimStyle.Render(fmt.Sprintf(" %ds", remaining)), nil
+}
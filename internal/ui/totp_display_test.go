@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+const testTOTPSecret = "JBSWY3DPEHPK3PXP"
+
+func TestFormatTOTPLineNeverIncludesRawSecret(t *testing.T) {
+	line, err := FormatTOTPLine(testTOTPSecret)
+	if err != nil {
+		t.Fatalf("FormatTOTPLine failed: %v", err)
+	}
+
+	if strings.Contains(line, testTOTPSecret) {
+		t.Errorf("Expected rendered line not to contain the raw secret, got %q", line)
+	}
+}
+
+func TestFormatTOTPLineUsesUrgentStyleNearExpiry(t *testing.T) {
+	code, remaining, err := totp.GenerateCode(testTOTPSecret)
+	if err != nil {
+		t.Fatalf("GenerateCode failed: %v", err)
+	}
+
+	line, err := FormatTOTPLine(testTOTPSecret)
+	if err != nil {
+		t.Fatalf("FormatTOTPLine failed: %v", err)
+	}
+
+	style := TOTPCodeStyle
+	if remaining <= 5 {
+		style = TOTPTimerUrgentStyle
+	}
+	want := style.Render(totp.FormatCode(code)) + DimStyle.Render(fmt.Sprintf(" %ds", remaining))
+
+	if line != want {
+		t.Errorf("Expected style to match the %ds-remaining threshold, got %q, want %q", remaining, line, want)
+	}
+}
+
+func TestFormatTOTPLineRejectsInvalidSecret(t *testing.T) {
+	if _, err := FormatTOTPLine("not-a-valid-secret"); err == nil {
+		t.Fatal("Expected an error for an invalid TOTP secret")
+	}
+}
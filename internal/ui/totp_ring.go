@@ -0,0 +1,43 @@
+// Package ui provides the TUI interface for the password manager.
+package ui
+
+import "strings"
+
+// totpRingWidth is the number of cells in the ring rendered by
+// RenderTOTPRing.
+const totpRingWidth = 8
+
+// RenderTOTPRing renders a small progress indicator showing how much of the
+// current TOTP period has elapsed, e.g. "▓▓▓▓▓░░░", styled urgently once
+// secondsRemaining drops to totpUrgentThreshold or below. period is the
+// code's validity period in seconds (DefaultPeriod for standard TOTP, but
+// some issuers use non-default periods).
+func RenderTOTPRing(secondsRemaining, period int) string {
+	if period <= 0 {
+		period = 30
+	}
+	if secondsRemaining < 0 {
+		secondsRemaining = 0
+	}
+	if secondsRemaining > period {
+		secondsRemaining = period
+	}
+
+	elapsed := period - secondsRemaining
+	filled := elapsed * totpRingWidth / period
+	if filled < 0 {
+		filled = 0
+	}
+	if filled > totpRingWidth {
+		filled = totpRingWidth
+	}
+	empty := totpRingWidth - filled
+
+	ring := strings.Repeat("▓", filled) + strings.Repeat("░", empty)
+
+	style := TOTPTimerStyle
+	if secondsRemaining <= 5 {
+		style = TOTPTimerUrgentStyle
+	}
+	return style.Render(ring)
+}
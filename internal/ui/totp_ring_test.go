@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderTOTPRingFillReflectsElapsedFraction(t *testing.T) {
+	full := RenderTOTPRing(30, 30)
+	if strings.Count(full, "▓") != 0 {
+		t.Errorf("Expected no elapsed fill with the full period remaining, got %q", full)
+	}
+
+	half := RenderTOTPRing(15, 30)
+	if strings.Count(half, "▓") != 4 {
+		t.Errorf("Expected half the ring filled at 15/30 remaining, got %q", half)
+	}
+
+	almostDone := RenderTOTPRing(0, 30)
+	if strings.Count(almostDone, "▓") != 8 {
+		t.Errorf("Expected a fully elapsed period to fill the ring, got %q", almostDone)
+	}
+}
+
+func TestRenderTOTPRingIsUrgentUnderThreshold(t *testing.T) {
+	urgent := RenderTOTPRing(5, 30)
+	calm := RenderTOTPRing(10, 30)
+
+	if urgent == calm {
+		t.Error("Expected the urgent and non-urgent renders to differ in style")
+	}
+}
+
+func TestRenderTOTPRingHandlesNonDefaultPeriod(t *testing.T) {
+	got := RenderTOTPRing(30, 60)
+	if strings.Count(got, "▓") != 4 {
+		t.Errorf("Expected a 60s period at 30s remaining to be half-elapsed, got %q", got)
+	}
+}
+
+func TestRenderTOTPRingClampsOutOfRangeInputs(t *testing.T) {
+	negative := RenderTOTPRing(-5, 30)
+	if strings.Count(negative, "▓") != 8 {
+		t.Errorf("Expected negative remaining to clamp to fully elapsed, got %q", negative)
+	}
+
+	over := RenderTOTPRing(100, 30)
+	if strings.Count(over, "▓") != 0 {
+		t.Errorf("Expected remaining over the period to clamp to no elapsed fill, got %q", over)
+	}
+}
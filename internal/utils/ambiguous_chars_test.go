@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeneratePasswordHonorsCustomAmbiguousChars(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:           40,
+		IncludeLowercase: true,
+		ExcludeAmbiguous: true,
+		AmbiguousChars:   "abc",
+	}
+
+	pw, err := GeneratePassword(opts)
+	if err != nil {
+		t.Fatalf("GeneratePassword failed: %v", err)
+	}
+	if strings.ContainsAny(pw, "abc") {
+		t.Errorf("Expected the custom ambiguous set to be stripped, got %q", pw)
+	}
+}
+
+func TestGeneratePasswordUsesDefaultAmbiguousCharsWhenEmpty(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:           40,
+		IncludeLowercase: true,
+		IncludeDigits:    true,
+		ExcludeAmbiguous: true,
+	}
+
+	pw, err := GeneratePassword(opts)
+	if err != nil {
+		t.Fatalf("GeneratePassword failed: %v", err)
+	}
+	if strings.ContainsAny(pw, "0O1lI") {
+		t.Errorf("Expected the default ambiguous set to be stripped, got %q", pw)
+	}
+}
@@ -0,0 +1,43 @@
+package utils
+
+import "strings"
+
+// PasswordAnalysis breaks a password down into per-character-class counts,
+// for an educational strength meter (e.g. "12 chars: 4 lower, 3 upper, 3
+// digits, 2 symbols").
+type PasswordAnalysis struct {
+	Length      int
+	Lowercase   int
+	Uppercase   int
+	Digits      int
+	Symbols     int
+	UniqueChars int
+	Strength    PasswordStrength
+}
+
+// AnalyzePassword computes a PasswordAnalysis for password.
+func AnalyzePassword(password string) PasswordAnalysis {
+	analysis := PasswordAnalysis{
+		Length:   len(password),
+		Strength: CalculateStrength(password),
+	}
+
+	seen := make(map[rune]struct{}, len(password))
+	for _, r := range password {
+		seen[r] = struct{}{}
+
+		switch {
+		case strings.ContainsRune(lowercase, r):
+			analysis.Lowercase++
+		case strings.ContainsRune(uppercase, r):
+			analysis.Uppercase++
+		case strings.ContainsRune(digits, r):
+			analysis.Digits++
+		case strings.ContainsRune(symbols, r):
+			analysis.Symbols++
+		}
+	}
+	analysis.UniqueChars = len(seen)
+
+	return analysis
+}
@@ -0,0 +1,50 @@
+package utils
+
+import "testing"
+
+func TestAnalyzePasswordCountsEachCharacterClass(t *testing.T) {
+	a := AnalyzePassword("Ab3!Ab3!")
+
+	if a.Length != 8 {
+		t.Errorf("Expected Length 8, got %d", a.Length)
+	}
+	if a.Lowercase != 2 {
+		t.Errorf("Expected 2 lowercase characters, got %d", a.Lowercase)
+	}
+	if a.Uppercase != 2 {
+		t.Errorf("Expected 2 uppercase characters, got %d", a.Uppercase)
+	}
+	if a.Digits != 2 {
+		t.Errorf("Expected 2 digits, got %d", a.Digits)
+	}
+	if a.Symbols != 2 {
+		t.Errorf("Expected 2 symbols, got %d", a.Symbols)
+	}
+}
+
+func TestAnalyzePasswordUniqueCharsIsLowerThanLengthWithRepeats(t *testing.T) {
+	a := AnalyzePassword("aaaaaaaa")
+
+	if a.Length != 8 {
+		t.Fatalf("Expected Length 8, got %d", a.Length)
+	}
+	if a.UniqueChars != 1 {
+		t.Errorf("Expected UniqueChars 1 for a password of repeated characters, got %d", a.UniqueChars)
+	}
+}
+
+func TestAnalyzePasswordUniqueCharsEqualsLengthWithNoRepeats(t *testing.T) {
+	a := AnalyzePassword("abcdefgh")
+
+	if a.UniqueChars != a.Length {
+		t.Errorf("Expected UniqueChars to equal Length with no repeats, got %d vs %d", a.UniqueChars, a.Length)
+	}
+}
+
+func TestAnalyzePasswordEmptyStringHasZeroCounts(t *testing.T) {
+	a := AnalyzePassword("")
+
+	if a.Length != 0 || a.Lowercase != 0 || a.Uppercase != 0 || a.Digits != 0 || a.Symbols != 0 || a.UniqueChars != 0 {
+		t.Errorf("Expected all-zero counts for an empty password, got %+v", a)
+	}
+}
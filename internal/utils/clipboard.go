@@ -3,6 +3,7 @@ package utils
 
 import (
 	"errors"
+	"os"
 	"sync"
 	"time"
 
@@ -15,18 +16,72 @@ var ErrClipboardUnavailable = errors.New("clipboard is not available on this sys
 // ClipboardManager manages clipboard operations with auto-clear functionality.
 type ClipboardManager struct {
 	mu          sync.Mutex
-	clearTimer  *time.Timer
+	clearTimer  scheduledTimer
 	lastContent string
 	timeout     time.Duration
+	clock       clock
+	// fileFallbackEnabled gates CopyWithFileFallback's temp-file fallback.
+	// Off by default, since writing a secret to disk (even 0600, even
+	// temporary) is a meaningfully different risk than putting it on the
+	// clipboard, and shouldn't happen without the caller opting in.
+	fileFallbackEnabled bool
 }
 
 // NewClipboardManager creates a new clipboard manager with the given timeout.
 func NewClipboardManager(timeout time.Duration) *ClipboardManager {
 	return &ClipboardManager{
 		timeout: timeout,
+		clock:   realClock{},
 	}
 }
 
+// SetClock overrides the clock used to schedule auto-clear. Used in tests to
+// advance time deterministically instead of waiting on real time.
+func (cm *ClipboardManager) SetClock(c clock) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.clock = c
+}
+
+// SetFileFallbackEnabled gates CopyWithFileFallback's temp-file fallback for
+// headless sessions (e.g. over SSH) where no clipboard is available.
+func (cm *ClipboardManager) SetFileFallbackEnabled(enabled bool) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.fileFallbackEnabled = enabled
+}
+
+// CopyWithFileFallback behaves like Copy, except that if the clipboard is
+// unsupported and fallback is enabled (see SetFileFallbackEnabled), it
+// writes text to a 0600 temp file instead of failing outright, and returns
+// that file's path so the caller can warn the user and point them at it.
+// fallbackPath is empty whenever the clipboard copy succeeded normally.
+func (cm *ClipboardManager) CopyWithFileFallback(text string) (fallbackPath string, err error) {
+	cm.mu.Lock()
+	unsupported := clipboard.Unsupported
+	fallbackEnabled := cm.fileFallbackEnabled
+	cm.mu.Unlock()
+
+	if !unsupported {
+		return "", cm.Copy(text)
+	}
+	if !fallbackEnabled {
+		return "", ErrClipboardUnavailable
+	}
+
+	f, err := os.CreateTemp("", "cipher0-clipboard-*.txt")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(text); err != nil {
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
 // Copy copies text to the clipboard and schedules auto-clear.
 func (cm *ClipboardManager) Copy(text string) error {
 	cm.mu.Lock()
@@ -47,7 +102,38 @@ func (cm *ClipboardManager) Copy(text string) error {
 	cm.lastContent = text
 
 	if cm.timeout > 0 {
-		cm.clearTimer = time.AfterFunc(cm.timeout, func() {
+		cm.clearTimer = cm.clock.AfterFunc(cm.timeout, func() {
+			_ = cm.Clear()
+		})
+	}
+
+	return nil
+}
+
+// CopyTransient behaves like Copy, but additionally requests that the
+// platform's clipboard history (where one exists) skip recording the value.
+// Currently only macOS honors this, via the org.nspasteboard hints; other
+// platforms fall back to a plain copy.
+func (cm *ClipboardManager) CopyTransient(text string) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if clipboard.Unsupported {
+		return ErrClipboardUnavailable
+	}
+
+	if cm.clearTimer != nil {
+		cm.clearTimer.Stop()
+	}
+
+	if err := writeTransientClipboard(text); err != nil {
+		return err
+	}
+
+	cm.lastContent = text
+
+	if cm.timeout > 0 {
+		cm.clearTimer = cm.clock.AfterFunc(cm.timeout, func() {
 			_ = cm.Clear()
 		})
 	}
@@ -124,7 +210,7 @@ func (cm *ClipboardManager) CopyWithNotification(text string) (<-chan bool, erro
 	ch := make(chan bool, 1)
 
 	if cm.timeout > 0 {
-		cm.clearTimer = time.AfterFunc(cm.timeout, func() {
+		cm.clearTimer = cm.clock.AfterFunc(cm.timeout, func() {
 			_ = cm.Clear()
 			ch <- true
 			close(ch)
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/atotto/clipboard"
+)
+
+func withUnsupportedClipboard(t *testing.T) {
+	t.Helper()
+	orig := clipboard.Unsupported
+	clipboard.Unsupported = true
+	t.Cleanup(func() { clipboard.Unsupported = orig })
+}
+
+func TestCopyWithFileFallbackWritesTempFileWhenEnabled(t *testing.T) {
+	withUnsupportedClipboard(t)
+
+	cm := NewClipboardManager(0)
+	cm.SetFileFallbackEnabled(true)
+
+	path, err := cm.CopyWithFileFallback("a secret")
+	if err != nil {
+		t.Fatalf("CopyWithFileFallback failed: %v", err)
+	}
+	if path == "" {
+		t.Fatal("Expected a non-empty fallback path")
+	}
+	defer os.Remove(path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read fallback file: %v", err)
+	}
+	if string(content) != "a secret" {
+		t.Errorf("Expected fallback file to contain %q, got %q", "a secret", string(content))
+	}
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("Failed to stat fallback file: %v", err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("Expected fallback file mode 0600, got %v", info.Mode().Perm())
+		}
+	}
+}
+
+func TestCopyWithFileFallbackErrorsWhenDisabled(t *testing.T) {
+	withUnsupportedClipboard(t)
+
+	cm := NewClipboardManager(0)
+
+	path, err := cm.CopyWithFileFallback("a secret")
+	if err != ErrClipboardUnavailable {
+		t.Errorf("Expected ErrClipboardUnavailable, got %v", err)
+	}
+	if path != "" {
+		t.Errorf("Expected an empty path on failure, got %q", path)
+	}
+}
+
+func TestCopyWithFileFallbackUsesClipboardWhenSupported(t *testing.T) {
+	if clipboard.Unsupported {
+		t.Skip("no clipboard available in this environment")
+	}
+
+	cm := NewClipboardManager(0)
+	cm.SetFileFallbackEnabled(true)
+
+	path, err := cm.CopyWithFileFallback("a secret")
+	if err != nil {
+		t.Fatalf("CopyWithFileFallback failed: %v", err)
+	}
+	if path != "" {
+		t.Errorf("Expected an empty fallback path when the clipboard is supported, got %q", path)
+	}
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/atotto/clipboard"
+)
+
+func TestClipboardManagerClearsOnceClockAdvancesPastTimeout(t *testing.T) {
+	if clipboard.Unsupported {
+		t.Skip("no clipboard available in this environment")
+	}
+
+	fc := newFakeClock()
+	cm := NewClipboardManager(5 * time.Second)
+	cm.SetClock(fc)
+
+	if err := cm.Copy("secret"); err != nil {
+		t.Fatalf("Copy failed: %v", err)
+	}
+
+	got, err := clipboard.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if got != "secret" {
+		t.Fatalf("Expected clipboard to contain %q, got %q", "secret", got)
+	}
+
+	fc.Advance(5 * time.Second)
+
+	got, err = clipboard.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if got != "" {
+		t.Fatalf("Expected clipboard to be cleared once the timeout elapsed, got %q", got)
+	}
+}
+
+func TestClipboardManagerCancelClearPreventsScheduledClear(t *testing.T) {
+	if clipboard.Unsupported {
+		t.Skip("no clipboard available in this environment")
+	}
+
+	fc := newFakeClock()
+	cm := NewClipboardManager(5 * time.Second)
+	cm.SetClock(fc)
+
+	if err := cm.Copy("secret"); err != nil {
+		t.Fatalf("Copy failed: %v", err)
+	}
+	cm.CancelClear()
+
+	fc.Advance(10 * time.Second)
+
+	got, err := clipboard.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if got != "secret" {
+		t.Fatalf("Expected CancelClear to prevent the scheduled clear, got %q", got)
+	}
+}
+
+func TestClipboardManagerCancelClearIsSafeWithNoPendingClear(t *testing.T) {
+	cm := NewClipboardManager(5 * time.Second)
+	cm.CancelClear()
+	cm.CancelClear()
+}
@@ -0,0 +1,23 @@
+//go:build darwin
+
+package utils
+
+// transientClipboardScript writes text to the general pasteboard and tags it
+// with the org.nspasteboard "concealed"/"transient" hints that clipboard
+// managers (Alfred, Maccy, Paste, etc.) use to skip recording an item.
+const transientClipboardScript = `
+ObjC.import('AppKit');
+function run(argv) {
+    var pb = $.NSPasteboard.generalPasteboard;
+    pb.clearContents;
+    pb.setStringForType($(argv[0]), $.NSPasteboardTypeString);
+    pb.setDataForType($.NSData.alloc.initWithLength(0), $('org.nspasteboard.ConcealedType'));
+    pb.setDataForType($.NSData.alloc.initWithLength(0), $('org.nspasteboard.TransientType'));
+}
+`
+
+// writeTransientClipboard writes text to the pasteboard with history-opt-out
+// hints via the same injectable runCommand used by OpenURL.
+func writeTransientClipboard(text string) error {
+	return runCommand("osascript", "-l", "JavaScript", "-e", transientClipboardScript, text)
+}
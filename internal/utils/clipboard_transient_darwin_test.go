@@ -0,0 +1,40 @@
+//go:build darwin
+
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteTransientClipboardRequestsConcealedHintsOnDarwin(t *testing.T) {
+	original := runCommand
+	var capturedArgs []string
+	SetExecRunner(func(name string, args ...string) error {
+		capturedArgs = append([]string{name}, args...)
+		return nil
+	})
+	t.Cleanup(func() { runCommand = original })
+
+	if err := writeTransientClipboard("secret"); err != nil {
+		t.Fatalf("writeTransientClipboard failed: %v", err)
+	}
+
+	if len(capturedArgs) == 0 || capturedArgs[0] != "osascript" {
+		t.Fatalf("Expected osascript to be invoked, got %v", capturedArgs)
+	}
+
+	var script string
+	for _, a := range capturedArgs {
+		if a == capturedArgs[len(capturedArgs)-1] {
+			continue
+		}
+		script += a
+	}
+	if !strings.Contains(script, "ConcealedType") || !strings.Contains(script, "TransientType") {
+		t.Errorf("Expected the script to request the concealed/transient pasteboard hints, got %q", script)
+	}
+	if capturedArgs[len(capturedArgs)-1] != "secret" {
+		t.Errorf("Expected the last argument to be the copied text, got %q", capturedArgs[len(capturedArgs)-1])
+	}
+}
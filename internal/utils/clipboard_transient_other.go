@@ -0,0 +1,11 @@
+//go:build !darwin
+
+package utils
+
+import "github.com/atotto/clipboard"
+
+// writeTransientClipboard falls back to a plain copy: the concealed/
+// transient pasteboard hints are a macOS-specific convention.
+func writeTransientClipboard(text string) error {
+	return clipboard.WriteAll(text)
+}
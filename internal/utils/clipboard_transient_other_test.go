@@ -0,0 +1,27 @@
+//go:build !darwin
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/atotto/clipboard"
+)
+
+func TestWriteTransientClipboardFallsBackToPlainCopy(t *testing.T) {
+	if clipboard.Unsupported {
+		t.Skip("no clipboard available in this environment")
+	}
+
+	if err := writeTransientClipboard("secret"); err != nil {
+		t.Fatalf("writeTransientClipboard failed: %v", err)
+	}
+
+	got, err := clipboard.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if got != "secret" {
+		t.Errorf("Expected a plain copy of the text, got %q", got)
+	}
+}
@@ -0,0 +1,22 @@
+package utils
+
+import "time"
+
+// scheduledTimer is the subset of *time.Timer that clock implementations
+// need to expose, so a fake clock can be substituted in tests.
+type scheduledTimer interface {
+	Stop() bool
+}
+
+// clock abstracts time.AfterFunc so timing-dependent types can accept an
+// injected clock in tests instead of waiting on real time.
+type clock interface {
+	AfterFunc(d time.Duration, f func()) scheduledTimer
+}
+
+// realClock schedules callbacks using the real wall clock.
+type realClock struct{}
+
+func (realClock) AfterFunc(d time.Duration, f func()) scheduledTimer {
+	return time.AfterFunc(d, f)
+}
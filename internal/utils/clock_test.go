@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// fakeTimer is the scheduledTimer returned by fakeClock.AfterFunc. Stop
+// prevents a pending callback from firing on the next Advance.
+type fakeTimer struct {
+	clock    *fakeClock
+	deadline time.Time
+	f        func()
+	stopped  bool
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasPending := !t.stopped
+	t.stopped = true
+	return wasPending
+}
+
+// fakeClock is a clock that only advances when Advance is called, so tests
+// can deterministically trigger timeouts instead of waiting on real time.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(0, 0)}
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) scheduledTimer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{clock: c, deadline: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the fake clock forward by d, synchronously firing (in
+// deadline order) the callback of every timer whose deadline has passed and
+// that hasn't been stopped.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	var due []*fakeTimer
+	for _, t := range c.timers {
+		if !t.stopped && !t.deadline.After(c.now) {
+			due = append(due, t)
+			t.stopped = true
+		}
+	}
+	c.mu.Unlock()
+
+	for _, t := range due {
+		t.f()
+	}
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// commonPasswords is a small, sorted (for IsCommonPassword's binary search)
+// sample of frequently breached/reused passwords, used to penalize obvious
+// choices that pass the character-variety checks in CalculateStrength
+// (e.g. "Password1!" scores well on variety alone but is still a terrible
+// password). Not exhaustive — a dedicated breach-check service (see
+// BreachInfo) catches what this list misses.
+var commonPasswords = []string{
+	"000000", "1111", "111111", "11111111", "112233", "11223344",
+	"1122334455", "121212", "121212121", "123123", "123321", "1234",
+	"12345", "123456", "1234567", "12345678", "123456789", "1234567890",
+	"1234qwer", "123qwe", "131313", "159753", "1a2b3c4d", "1q2w3e4r5t",
+	"1qaz2wsx", "1qaz2wsx3edc", "2000", "555555", "654321", "666666",
+	"6969", "696969", "777777", "7777777", "987654321", "aaaa1111",
+	"aaaaaa", "abc123", "abcd1234", "abcdefg", "abcdefgh", "access",
+	"admin", "airforce", "alabama", "amanda", "andrea", "andrew",
+	"arsenal", "asd123", "asdasd", "asdf1234", "asdfgh", "asdzxc",
+	"ashley", "asshole", "auburn", "austin", "banana", "baseball",
+	"baseball1", "batman", "baylor", "berkeley", "biteme", "bronco",
+	"brown1", "bu1234", "buster", "caltech", "camaro", "celtics",
+	"changeme", "charlie", "cheese", "chelsea", "chelsea1", "chicken",
+	"clemson", "columbia", "computer", "cornell", "corvette", "cowboy",
+	"cowboys", "cricket", "dallas", "daniel", "dartmouth", "default",
+	"diamond", "donald", "dragon", "dragon123", "duke", "eagle1",
+	"falcon", "florida", "flower", "football", "football1", "freedom",
+	"fucker", "fuckyou", "gators", "george", "georgia", "gfhjkm",
+	"ginger", "ginger123", "gohabs", "gonavy", "guest", "hannah",
+	"harley", "harvard", "heather", "hello", "hello123", "hockey",
+	"hottie", "hunter", "iloveyou", "iloveyou1", "indiana", "internet",
+	"jasmine", "jennifer", "jessica", "jordan23", "joshua", "kansas",
+	"killer", "klaster", "knicks", "lakers", "letmein", "letmein1",
+	"letmein123", "liverpool", "login", "love", "loveme", "maggie",
+	"marine", "martin", "master", "master123", "matrix", "matthew",
+	"melissa", "mercedes", "merlin", "michael", "michelle", "minecraft",
+	"mit1234", "monkey", "monkey123", "mustang", "newpass", "nicole",
+	"northwestern", "nyu1234", "orange", "oregon", "p@ssw0rd", "p@ssword",
+	"packers", "pass", "passw0rd", "passw0rd1", "password", "peanut",
+	"pepper", "pokemon", "princess", "princeton", "purdue", "purple",
+	"q1w2e3r4", "q1w2e3r4t5", "qazwsx", "qazxsw", "qwe123", "qweqwe",
+	"qwerty", "qwerty123", "qwertyuiop", "raiders1", "ranger", "redsox",
+	"robert", "rockets", "rush2112", "rutgers", "samantha", "scooter",
+	"secret", "semper", "shadow", "soccer", "soccer1", "stanford",
+	"starwars", "starwars1", "steelers", "stephanie", "summer", "sunshine",
+	"superman", "tarheels", "taylor", "temp1234", "temple", "test1234",
+	"thomas", "thunder", "tigger", "trustno1", "ucla", "upenn",
+	"usc", "usmc", "welcome", "welcome1", "whatever", "william",
+	"yale", "yankees", "yankees1", "zaq12wsx", "zaq1zaq1", "zxc123",
+	"zxcvbn", "zxcvbnm",
+}
+
+// IsCommonPassword reports whether p (case-insensitive) appears in
+// commonPasswords, via binary search since the list is kept sorted.
+func IsCommonPassword(p string) bool {
+	p = normalizeForCommonCheck(p)
+	i := sort.SearchStrings(commonPasswords, p)
+	return i < len(commonPasswords) && commonPasswords[i] == p
+}
+
+func normalizeForCommonCheck(p string) string {
+	return strings.ToLower(p)
+}
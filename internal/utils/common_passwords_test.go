@@ -0,0 +1,25 @@
+package utils
+
+import "testing"
+
+func TestIsCommonPasswordFlagsKnownCommonPasswords(t *testing.T) {
+	for _, p := range []string{"123456", "password", "PASSWORD", "qwerty", "abc123"} {
+		if !IsCommonPassword(p) {
+			t.Errorf("Expected %q to be flagged as a common password", p)
+		}
+	}
+}
+
+func TestIsCommonPasswordDoesNotFlagRandomStrings(t *testing.T) {
+	for _, p := range []string{"xk9#mQ2pL7vR", "Tz8!wNb4eHq1", ""} {
+		if IsCommonPassword(p) {
+			t.Errorf("Expected %q not to be flagged as a common password", p)
+		}
+	}
+}
+
+func TestIsCommonPasswordIsCaseInsensitive(t *testing.T) {
+	if !IsCommonPassword("Password") {
+		t.Error("Expected the common-password check to be case-insensitive")
+	}
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"errors"
+	"math"
+)
+
+// MaxPasswordLength is the longest password GeneratePassword will produce.
+const MaxPasswordLength = 128
+
+// ErrEntropyTargetUnreachable is returned when no length up to
+// MaxPasswordLength reaches the requested entropy with the given charset.
+var ErrEntropyTargetUnreachable = errors.New("no password length up to the maximum reaches the requested entropy")
+
+// EstimateEntropyBits estimates the entropy of a password generated with
+// opts, assuming each character is drawn independently and uniformly from
+// BuildCharset(opts).
+func EstimateEntropyBits(opts GeneratorOptions) float64 {
+	charsetLen := len(BuildCharset(opts))
+	if charsetLen < 2 || opts.Length < 1 {
+		return 0
+	}
+	return float64(opts.Length) * math.Log2(float64(charsetLen))
+}
+
+// GenerateWithMinEntropy generates a password like GeneratePassword, but
+// first extends opts.Length (up to MaxPasswordLength) until the estimated
+// entropy meets minBits. It returns ErrEntropyTargetUnreachable if minBits
+// can't be reached within MaxPasswordLength.
+func GenerateWithMinEntropy(opts GeneratorOptions, minBits float64) (string, error) {
+	if opts.Length < 1 {
+		opts.Length = 16
+	}
+	for opts.Length < MaxPasswordLength && EstimateEntropyBits(opts) < minBits {
+		opts.Length++
+	}
+	if EstimateEntropyBits(opts) < minBits {
+		return "", ErrEntropyTargetUnreachable
+	}
+	return GeneratePassword(opts)
+}
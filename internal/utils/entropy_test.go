@@ -0,0 +1,54 @@
+package utils
+
+import "testing"
+
+func TestGenerateWithMinEntropyExtendsShortLowEntropyRequest(t *testing.T) {
+	opts := GeneratorOptions{Length: 4, IncludeDigits: true}
+
+	pw, err := GenerateWithMinEntropy(opts, 40)
+	if err != nil {
+		t.Fatalf("GenerateWithMinEntropy failed: %v", err)
+	}
+	if len(pw) <= 4 {
+		t.Fatalf("Expected the length to be extended beyond 4, got %d", len(pw))
+	}
+
+	extended := opts
+	extended.Length = len(pw)
+	if EstimateEntropyBits(extended) < 40 {
+		t.Errorf("Expected the extended password to reach the requested entropy, got %f bits", EstimateEntropyBits(extended))
+	}
+}
+
+func TestGenerateWithMinEntropyReturnsErrorWhenUnreachable(t *testing.T) {
+	opts := GeneratorOptions{Length: 4, IncludeDigits: true}
+
+	_, err := GenerateWithMinEntropy(opts, 1_000_000)
+	if err != ErrEntropyTargetUnreachable {
+		t.Fatalf("Expected ErrEntropyTargetUnreachable, got %v", err)
+	}
+}
+
+func TestGenerateWithMinEntropyCapsAtMaxPasswordLength(t *testing.T) {
+	opts := GeneratorOptions{Length: 4, IncludeDigits: true}
+
+	pw, err := GenerateWithMinEntropy(opts, 1_000_000)
+	if err == nil {
+		t.Fatalf("Expected an error for an unreachable target, got password of length %d", len(pw))
+	}
+}
+
+func TestEstimateEntropyBitsScalesWithLengthAndCharsetSize(t *testing.T) {
+	short := GeneratorOptions{Length: 8, IncludeDigits: true}
+	long := GeneratorOptions{Length: 16, IncludeDigits: true}
+
+	if EstimateEntropyBits(long) <= EstimateEntropyBits(short) {
+		t.Error("Expected a longer password to have higher estimated entropy")
+	}
+
+	digitsOnly := GeneratorOptions{Length: 8, IncludeDigits: true}
+	allClasses := GeneratorOptions{Length: 8, IncludeDigits: true, IncludeLowercase: true, IncludeUppercase: true, IncludeSymbols: true}
+	if EstimateEntropyBits(allClasses) <= EstimateEntropyBits(digitsOnly) {
+		t.Error("Expected a larger charset to have higher estimated entropy at the same length")
+	}
+}
@@ -0,0 +1,17 @@
+package utils
+
+// GenerateAndCopy generates a password with opts, copies it to the clipboard
+// (starting the manager's auto-clear timer), and returns the generated value
+// so the caller can display what was copied.
+func GenerateAndCopy(cm *ClipboardManager, opts GeneratorOptions) (string, error) {
+	password, err := GeneratePassword(opts)
+	if err != nil {
+		return "", err
+	}
+
+	if err := cm.Copy(password); err != nil {
+		return "", err
+	}
+
+	return password, nil
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/atotto/clipboard"
+)
+
+func TestGenerateAndCopyCopiesTheReturnedPassword(t *testing.T) {
+	if clipboard.Unsupported {
+		t.Skip("no clipboard available in this environment")
+	}
+
+	cm := NewClipboardManager(0)
+	opts := DefaultGeneratorOptions()
+	opts.Length = 16
+
+	password, err := GenerateAndCopy(cm, opts)
+	if err != nil {
+		t.Fatalf("GenerateAndCopy failed: %v", err)
+	}
+	if len(password) != opts.Length {
+		t.Fatalf("Expected a generated password of length %d, got %d", opts.Length, len(password))
+	}
+
+	got, err := clipboard.ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if got != password {
+		t.Errorf("Expected the clipboard to contain the returned password %q, got %q", password, got)
+	}
+}
+
+func TestGenerateAndCopyPropagatesGenerationError(t *testing.T) {
+	cm := NewClipboardManager(0)
+	opts := DefaultGeneratorOptions()
+	opts.IncludeDigits = false
+	opts.PositionConstraints = PositionConstraints{LastClass: ClassDigit}
+
+	if _, err := GenerateAndCopy(cm, opts); err != ErrInvalidPositionConstraint {
+		t.Fatalf("Expected ErrInvalidPositionConstraint, got %v", err)
+	}
+}
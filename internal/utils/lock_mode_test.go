@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewAutoLockTimerDefaultsToIdleMode(t *testing.T) {
+	fc := newFakeClock()
+	fired := 0
+	timer := NewAutoLockTimer(5*time.Second, func() { fired++ })
+	timer.SetClock(fc)
+
+	timer.Start()
+	fc.Advance(3 * time.Second)
+	timer.Reset()
+	fc.Advance(3 * time.Second)
+	if fired != 0 {
+		t.Fatalf("Expected the default mode to be idle, so Reset should push the deadline back, fired %d times", fired)
+	}
+}
+
+func TestSetModeInvalidValueFallsBackToIdle(t *testing.T) {
+	fc := newFakeClock()
+	fired := 0
+	timer := NewAutoLockTimer(5*time.Second, func() { fired++ })
+	timer.SetClock(fc)
+	timer.SetMode(LockMode("bogus"))
+
+	timer.Start()
+	fc.Advance(3 * time.Second)
+	timer.Reset()
+	fc.Advance(3 * time.Second)
+	if fired != 0 {
+		t.Fatalf("Expected an invalid mode to fall back to idle, so Reset should push the deadline back, fired %d times", fired)
+	}
+}
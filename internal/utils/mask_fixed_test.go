@@ -0,0 +1,28 @@
+package utils
+
+import "testing"
+
+func TestMaskFixedIsSameWidthRegardlessOfSecretLength(t *testing.T) {
+	short := MaskFixed("abcd", 12)
+	long := MaskFixed("a-much-longer-password-than-that", 12)
+
+	if short != long {
+		t.Errorf("Expected masks of the same width for different secret lengths, got %q and %q", short, long)
+	}
+	if len([]rune(short)) != 12 {
+		t.Errorf("Expected a 12-bullet mask, got %q", short)
+	}
+}
+
+func TestMaskFixedEmptyStringRendersSpaces(t *testing.T) {
+	got := MaskFixed("", 5)
+	if got != "     " {
+		t.Errorf("Expected 5 spaces for an empty secret, got %q", got)
+	}
+}
+
+func TestMaskFixedClampsNegativeWidth(t *testing.T) {
+	if got := MaskFixed("secret", -3); got != "" {
+		t.Errorf("Expected a negative width to clamp to empty, got %q", got)
+	}
+}
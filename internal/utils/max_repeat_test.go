@@ -0,0 +1,87 @@
+package utils
+
+import "testing"
+
+func TestGeneratePasswordWithMaxRepeatOneHasNoAdjacentDuplicates(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:           20,
+		IncludeLowercase: true,
+		IncludeDigits:    true,
+		MaxRepeat:        1,
+	}
+
+	for i := 0; i < 50; i++ {
+		pw, err := GeneratePassword(opts)
+		if err != nil {
+			t.Fatalf("GeneratePassword failed: %v", err)
+		}
+		for j := 1; j < len(pw); j++ {
+			if pw[j] == pw[j-1] {
+				t.Fatalf("Expected no adjacent repeated characters with MaxRepeat=1, got %q", pw)
+			}
+		}
+	}
+}
+
+func TestExceedsMaxRepeatUnlimitedWhenZero(t *testing.T) {
+	if exceedsMaxRepeat([]byte("aaaa"), 'a', 0) {
+		t.Error("Expected MaxRepeat=0 to mean unlimited")
+	}
+}
+
+func TestExceedsMaxRepeatDetectsTooLongARun(t *testing.T) {
+	if !exceedsMaxRepeat([]byte("aa"), 'a', 2) {
+		t.Error("Expected a third consecutive 'a' to exceed MaxRepeat=2")
+	}
+	if exceedsMaxRepeat([]byte("aa"), 'b', 2) {
+		t.Error("Expected a different character not to extend the existing run")
+	}
+}
+
+// TestGeneratePasswordHonorsMaxRepeatAgainstForcedLastPosition reproduces a
+// case where MaxRepeat and PositionConstraints used to silently conflict:
+// with only digits enabled and a length of 3, the forced last digit used to
+// be drawn independently of password[1], so it could duplicate it despite
+// MaxRepeat=1 promising no repeats anywhere in the password.
+func TestGeneratePasswordHonorsMaxRepeatAgainstForcedLastPosition(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:              3,
+		IncludeDigits:       true,
+		MaxRepeat:           1,
+		PositionConstraints: PositionConstraints{LastClass: ClassDigit},
+	}
+
+	for i := 0; i < 200; i++ {
+		pw, err := GeneratePassword(opts)
+		if err != nil {
+			t.Fatalf("GeneratePassword failed: %v", err)
+		}
+		for j := 1; j < len(pw); j++ {
+			if pw[j] == pw[j-1] {
+				t.Fatalf("Expected no adjacent repeated characters with MaxRepeat=1, got %q", pw)
+			}
+		}
+	}
+}
+
+// TestGeneratePasswordHonorsMaxRepeatAgainstForcedFirstAndLastPosition
+// exercises both forced positions together, including the boundary where
+// the forced last position's neighbor is itself the forced first position.
+func TestGeneratePasswordHonorsMaxRepeatAgainstForcedFirstAndLastPosition(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:              2,
+		IncludeUppercase:    true,
+		MaxRepeat:           1,
+		PositionConstraints: PositionConstraints{FirstClass: ClassUpper, LastClass: ClassUpper},
+	}
+
+	for i := 0; i < 200; i++ {
+		pw, err := GeneratePassword(opts)
+		if err != nil {
+			t.Fatalf("GeneratePassword failed: %v", err)
+		}
+		if pw[0] == pw[1] {
+			t.Fatalf("Expected no adjacent repeated characters with MaxRepeat=1, got %q", pw)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeneratePasswordMobileFriendlyUsesOnlyAllowedSymbols(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:           30,
+		IncludeLowercase: true,
+		IncludeSymbols:   true,
+		MobileFriendly:   true,
+	}
+
+	for i := 0; i < 20; i++ {
+		pw, err := GeneratePassword(opts)
+		if err != nil {
+			t.Fatalf("GeneratePassword failed: %v", err)
+		}
+		for _, c := range pw {
+			if strings.ContainsRune(lowercase, c) {
+				continue
+			}
+			if !strings.ContainsRune("!@#$&*?", c) {
+				t.Fatalf("Expected only lowercase or mobile-friendly symbols, got character %q in %q", c, pw)
+			}
+		}
+	}
+}
+
+func TestGeneratePasswordMobileFriendlyStillIncludesRequestedClasses(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:           40,
+		IncludeLowercase: true,
+		IncludeUppercase: true,
+		IncludeDigits:    true,
+		IncludeSymbols:   true,
+		MobileFriendly:   true,
+	}
+
+	pw, err := GeneratePassword(opts)
+	if err != nil {
+		t.Fatalf("GeneratePassword failed: %v", err)
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, c := range pw {
+		switch {
+		case strings.ContainsRune(lowercase, c):
+			hasLower = true
+		case strings.ContainsRune(uppercase, c):
+			hasUpper = true
+		case strings.ContainsRune(digits, c):
+			hasDigit = true
+		case strings.ContainsRune("!@#$&*?", c):
+			hasSymbol = true
+		}
+	}
+	if !hasLower || !hasUpper || !hasDigit || !hasSymbol {
+		t.Errorf("Expected all requested classes to appear, got %q", pw)
+	}
+}
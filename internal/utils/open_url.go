@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnsupportedURLScheme is returned when OpenURL is asked to open anything
+// other than http(s), to avoid launching arbitrary URI handlers.
+var ErrUnsupportedURLScheme = errors.New("only http and https URLs can be opened")
+
+// execRunner runs an external command. Overridable in tests.
+type execRunner func(name string, args ...string) error
+
+var runCommand execRunner = func(name string, args ...string) error {
+	return exec.Command(name, args...).Run()
+}
+
+// SetExecRunner overrides the runner OpenURL uses to launch the browser.
+// Used in tests to capture invocations instead of actually opening a browser.
+func SetExecRunner(r execRunner) {
+	runCommand = r
+}
+
+// OpenURL normalizes raw and launches it in the platform's default browser.
+// Only http and https schemes are allowed.
+func OpenURL(raw string) error {
+	normalized := NormalizeURL(raw)
+
+	lower := strings.ToLower(normalized)
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		return ErrUnsupportedURLScheme
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		return runCommand("open", normalized)
+	case "windows":
+		return runCommand("rundll32", "url.dll,FileProtocolHandler", normalized)
+	default:
+		return runCommand("xdg-open", normalized)
+	}
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func withExecRunner(t *testing.T, r execRunner) *[]string {
+	t.Helper()
+	original := runCommand
+	var captured []string
+	SetExecRunner(func(name string, args ...string) error {
+		captured = append([]string{name}, args...)
+		if r != nil {
+			return r(name, args...)
+		}
+		return nil
+	})
+	t.Cleanup(func() { runCommand = original })
+	return &captured
+}
+
+func TestOpenURLInvokesRunnerForHTTPS(t *testing.T) {
+	captured := withExecRunner(t, nil)
+
+	if err := OpenURL("example.com"); err != nil {
+		t.Fatalf("OpenURL failed: %v", err)
+	}
+
+	if len(*captured) < 2 {
+		t.Fatalf("Expected the runner to be invoked with a command and the normalized URL, got %v", *captured)
+	}
+	if last := (*captured)[len(*captured)-1]; last != "https://example.com" {
+		t.Errorf("Expected the normalized URL to be passed to the runner, got %q", last)
+	}
+}
+
+func TestOpenURLInvokesRunnerForExplicitHTTP(t *testing.T) {
+	captured := withExecRunner(t, nil)
+
+	if err := OpenURL("http://example.com"); err != nil {
+		t.Fatalf("OpenURL failed: %v", err)
+	}
+	if last := (*captured)[len(*captured)-1]; last != "http://example.com" {
+		t.Errorf("Expected the http URL to be passed to the runner unchanged, got %q", last)
+	}
+}
+
+func TestOpenURLRejectsFileScheme(t *testing.T) {
+	captured := withExecRunner(t, nil)
+
+	if err := OpenURL("file:///etc/passwd"); !errors.Is(err, ErrUnsupportedURLScheme) {
+		t.Fatalf("Expected ErrUnsupportedURLScheme, got %v", err)
+	}
+	if len(*captured) != 0 {
+		t.Errorf("Expected the runner not to be invoked for a rejected scheme, got %v", *captured)
+	}
+}
+
+func TestOpenURLRejectsJavascriptScheme(t *testing.T) {
+	captured := withExecRunner(t, nil)
+
+	if err := OpenURL("javascript:alert(1)"); !errors.Is(err, ErrUnsupportedURLScheme) {
+		t.Fatalf("Expected ErrUnsupportedURLScheme, got %v", err)
+	}
+	if len(*captured) != 0 {
+		t.Errorf("Expected the runner not to be invoked for a rejected scheme, got %v", *captured)
+	}
+}
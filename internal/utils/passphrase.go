@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"crypto/rand"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/luxfi/go-bip39/wordlists"
+)
+
+// ErrInvalidWordlistSize is returned by GeneratePassphraseForEntropy when
+// wordlistSize isn't a usable slice of the bundled word list (see
+// wordlists.English).
+var ErrInvalidWordlistSize = errors.New("wordlist size must be positive and not exceed the bundled word list size")
+
+// GeneratePassphraseForEntropy generates a passphrase meeting or exceeding
+// targetBits of entropy, drawing words from the first wordlistSize entries
+// of the bundled BIP39 English word list (the same one crypto.GenerateRecoveryPhrase
+// draws its full 2048-word list from) joined by sep. Rather than asking the
+// caller to pick a word count, it computes the minimum count that reaches
+// targetBits given wordlistSize's bits-per-word (log2(wordlistSize)) and
+// returns the entropy actually achieved, which is always >= targetBits
+// since word count is rounded up.
+func GeneratePassphraseForEntropy(targetBits float64, wordlistSize int, sep string) (phrase string, actualBits float64, err error) {
+	if wordlistSize <= 0 || wordlistSize > len(wordlists.English) {
+		return "", 0, ErrInvalidWordlistSize
+	}
+	pool := wordlists.English[:wordlistSize]
+
+	bitsPerWord := math.Log2(float64(wordlistSize))
+	wordCount := int(math.Ceil(targetBits / bitsPerWord))
+	if wordCount < 1 {
+		wordCount = 1
+	}
+
+	words := make([]string, wordCount)
+	for i := range words {
+		n, err := rand.Int(randReader, big.NewInt(int64(wordlistSize)))
+		if err != nil {
+			return "", 0, err
+		}
+		words[i] = pool[n.Int64()]
+	}
+
+	return strings.Join(words, sep), float64(wordCount) * bitsPerWord, nil
+}
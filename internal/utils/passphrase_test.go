@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeneratePassphraseForEntropyMeetsTarget(t *testing.T) {
+	phrase, actualBits, err := GeneratePassphraseForEntropy(60, 2048, "-")
+	if err != nil {
+		t.Fatalf("GeneratePassphraseForEntropy failed: %v", err)
+	}
+	if actualBits < 60 {
+		t.Errorf("Expected at least 60 bits of entropy, got %f", actualBits)
+	}
+	if len(strings.Split(phrase, "-")) < 1 {
+		t.Errorf("Expected a non-empty phrase, got %q", phrase)
+	}
+}
+
+func TestGeneratePassphraseForEntropyWordCountScalesWithTarget(t *testing.T) {
+	low, _, err := GeneratePassphraseForEntropy(20, 2048, " ")
+	if err != nil {
+		t.Fatalf("GeneratePassphraseForEntropy failed: %v", err)
+	}
+	high, _, err := GeneratePassphraseForEntropy(100, 2048, " ")
+	if err != nil {
+		t.Fatalf("GeneratePassphraseForEntropy failed: %v", err)
+	}
+
+	lowWords := len(strings.Fields(low))
+	highWords := len(strings.Fields(high))
+	if highWords <= lowWords {
+		t.Errorf("Expected a higher entropy target to require more words, got %d vs %d", lowWords, highWords)
+	}
+}
+
+func TestGeneratePassphraseForEntropyRejectsInvalidWordlistSize(t *testing.T) {
+	if _, _, err := GeneratePassphraseForEntropy(40, 0, " "); err != ErrInvalidWordlistSize {
+		t.Errorf("Expected ErrInvalidWordlistSize for a zero wordlist size, got %v", err)
+	}
+	if _, _, err := GeneratePassphraseForEntropy(40, 1_000_000, " "); err != ErrInvalidWordlistSize {
+		t.Errorf("Expected ErrInvalidWordlistSize for an oversized wordlist, got %v", err)
+	}
+}
+
+func TestGeneratePassphraseForEntropyUsesSeparator(t *testing.T) {
+	phrase, _, err := GeneratePassphraseForEntropy(30, 2048, "_")
+	if err != nil {
+		t.Fatalf("GeneratePassphraseForEntropy failed: %v", err)
+	}
+	if strings.Contains(phrase, " ") {
+		t.Errorf("Expected the custom separator to be used instead of spaces, got %q", phrase)
+	}
+}
@@ -3,10 +3,30 @@ package utils
 
 import (
 	"crypto/rand"
+	"errors"
+	"io"
 	"math/big"
 	"strings"
 )
 
+// randReader is the randomness source GeneratePassword draws from.
+// Overridable in tests via SetRandReader to get stable, known output for a
+// given set of options; production code never changes it from rand.Reader.
+var randReader io.Reader = rand.Reader
+
+// SetRandReader overrides the randomness source used by GeneratePassword.
+// Pass nil to restore the default crypto/rand.Reader.
+func SetRandReader(r io.Reader) {
+	if r == nil {
+		r = rand.Reader
+	}
+	randReader = r
+}
+
+// ErrInvalidPositionConstraint is returned when a PositionConstraints class
+// requires a character class that isn't enabled in the generator options.
+var ErrInvalidPositionConstraint = errors.New("position constraint requires a character class that is disabled")
+
 // PasswordStrength represents the strength level of a password.
 type PasswordStrength int
 
@@ -72,14 +92,50 @@ func (s PasswordStrength) Percentage() int {
 	}
 }
 
+// CharClass identifies a character class for position constraints.
+type CharClass int
+
+const (
+	// ClassAny means no constraint is placed on the position.
+	ClassAny CharClass = iota
+	ClassLower
+	ClassUpper
+	ClassDigit
+	ClassSymbol
+)
+
+// PositionConstraints forces specific character classes at the first and/or
+// last position of a generated password, e.g. to satisfy systems requiring
+// the password to start with a letter or end with a digit. ClassAny leaves
+// the position unconstrained.
+type PositionConstraints struct {
+	FirstClass CharClass
+	LastClass  CharClass
+}
+
 // GeneratorOptions configures the password generator.
 type GeneratorOptions struct {
-	Length           int
-	IncludeUppercase bool
-	IncludeLowercase bool
-	IncludeDigits    bool
-	IncludeSymbols   bool
-	ExcludeAmbiguous bool
+	Length              int
+	IncludeUppercase    bool
+	IncludeLowercase    bool
+	IncludeDigits       bool
+	IncludeSymbols      bool
+	ExcludeAmbiguous    bool
+	PositionConstraints PositionConstraints
+	// SymbolSet overrides the default symbol charset when non-empty, e.g. to
+	// satisfy sites that only allow a subset like "!@#$%".
+	SymbolSet string
+	// MaxRepeat caps how many times the same character may appear in a row,
+	// e.g. to satisfy sites that reject passwords with three identical
+	// characters in a row. 0 means unlimited.
+	MaxRepeat int
+	// MobileFriendly restricts symbols to an easily-reachable subset on a
+	// phone keyboard and forces ExcludeAmbiguous, overriding SymbolSet.
+	MobileFriendly bool
+	// AmbiguousChars overrides the default set of characters ExcludeAmbiguous
+	// strips, e.g. because a particular font renders a different subset of
+	// characters confusingly. Empty means the package default (ambiguousChars).
+	AmbiguousChars string
 }
 
 // DefaultGeneratorOptions returns sensible default options.
@@ -100,15 +156,18 @@ const (
 	digits         = "0123456789"
 	symbols        = "!@#$%^&*()_+-=[]{}|;:,.<>?"
 	ambiguousChars = "0O1lI"
+	// mobileFriendlySymbols is a subset of symbols that sits on the primary
+	// (non-shifted-twice) layer of most mobile keyboards, for
+	// GeneratorOptions.MobileFriendly.
+	mobileFriendlySymbols = "!@#$&*?"
 )
 
-// GeneratePassword generates a random password with the given options.
-func GeneratePassword(opts GeneratorOptions) (string, error) {
-	if opts.Length < 1 {
-		opts.Length = 16
-	}
-	if opts.Length > 128 {
-		opts.Length = 128
+// BuildCharset composes the full character set opts draws from: every
+// enabled class concatenated, ambiguous characters stripped if requested,
+// falling back to lowercase+digits when no class is enabled.
+func BuildCharset(opts GeneratorOptions) string {
+	if opts.MobileFriendly {
+		opts.ExcludeAmbiguous = true
 	}
 
 	var charset string
@@ -122,7 +181,7 @@ func GeneratePassword(opts GeneratorOptions) (string, error) {
 		charset += digits
 	}
 	if opts.IncludeSymbols {
-		charset += symbols
+		charset += effectiveSymbols(opts)
 	}
 
 	if charset == "" {
@@ -130,25 +189,206 @@ func GeneratePassword(opts GeneratorOptions) (string, error) {
 	}
 
 	if opts.ExcludeAmbiguous {
-		for _, c := range ambiguousChars {
+		for _, c := range effectiveAmbiguousChars(opts) {
 			charset = strings.ReplaceAll(charset, string(c), "")
 		}
 	}
 
+	return charset
+}
+
+// GeneratePassword generates a random password with the given options.
+func GeneratePassword(opts GeneratorOptions) (string, error) {
+	if opts.Length < 1 {
+		opts.Length = 16
+	}
+	if opts.Length > 128 {
+		opts.Length = 128
+	}
+
+	charset := BuildCharset(opts)
+
+	firstCharset, err := classCharset(opts, opts.PositionConstraints.FirstClass)
+	if err != nil {
+		return "", err
+	}
+	lastCharset, err := classCharset(opts, opts.PositionConstraints.LastClass)
+	if err != nil {
+		return "", err
+	}
+
 	password := make([]byte, opts.Length)
 	charsetLen := big.NewInt(int64(len(charset)))
 
 	for i := 0; i < opts.Length; i++ {
-		n, err := rand.Int(rand.Reader, charsetLen)
-		if err != nil {
+		for {
+			n, err := rand.Int(randReader, charsetLen)
+			if err != nil {
+				return "", err
+			}
+			c := charset[n.Int64()]
+			if exceedsMaxRepeat(password[:i], c, opts.MaxRepeat) {
+				continue
+			}
+			password[i] = c
+			break
+		}
+	}
+
+	if firstCharset != "" {
+		if err := fillConstrainedByte(password, 0, firstCharset, opts.MaxRepeat); err != nil {
+			return "", err
+		}
+	}
+	if lastCharset != "" {
+		if err := fillConstrainedByte(password, len(password)-1, lastCharset, opts.MaxRepeat); err != nil {
 			return "", err
 		}
-		password[i] = charset[n.Int64()]
 	}
 
 	return string(password), nil
 }
 
+// exceedsMaxRepeat reports whether appending c to prefix would create a run
+// of identical characters longer than maxRepeat. maxRepeat <= 0 means
+// unlimited, so it never exceeds.
+func exceedsMaxRepeat(prefix []byte, c byte, maxRepeat int) bool {
+	if maxRepeat <= 0 {
+		return false
+	}
+	run := 1
+	for i := len(prefix) - 1; i >= 0 && prefix[i] == c; i-- {
+		run++
+		if run > maxRepeat {
+			return true
+		}
+	}
+	return run > maxRepeat
+}
+
+// exceedsMaxRepeatAt reports whether setting password[pos] to c would create
+// a run of identical characters longer than maxRepeat, counting both
+// already-filled neighbors (unlike exceedsMaxRepeat, which only looks at a
+// left-hand prefix). Used by fillConstrainedByte, since the forced first/last
+// position can have an already-filled neighbor on either side.
+func exceedsMaxRepeatAt(password []byte, pos int, c byte, maxRepeat int) bool {
+	if maxRepeat <= 0 {
+		return false
+	}
+	run := 1
+	for i := pos - 1; i >= 0 && password[i] == c; i-- {
+		run++
+	}
+	for i := pos + 1; i < len(password) && password[i] == c; i++ {
+		run++
+	}
+	return run > maxRepeat
+}
+
+// classCharset resolves a CharClass to the (ambiguous-filtered) charset it
+// draws from, returning ErrInvalidPositionConstraint if the class isn't
+// enabled by opts.
+func classCharset(opts GeneratorOptions, class CharClass) (string, error) {
+	if opts.MobileFriendly {
+		opts.ExcludeAmbiguous = true
+	}
+
+	var set string
+	switch class {
+	case ClassAny:
+		return "", nil
+	case ClassLower:
+		if !opts.IncludeLowercase {
+			return "", ErrInvalidPositionConstraint
+		}
+		set = lowercase
+	case ClassUpper:
+		if !opts.IncludeUppercase {
+			return "", ErrInvalidPositionConstraint
+		}
+		set = uppercase
+	case ClassDigit:
+		if !opts.IncludeDigits {
+			return "", ErrInvalidPositionConstraint
+		}
+		set = digits
+	case ClassSymbol:
+		if !opts.IncludeSymbols {
+			return "", ErrInvalidPositionConstraint
+		}
+		set = effectiveSymbols(opts)
+	default:
+		return "", ErrInvalidPositionConstraint
+	}
+
+	if opts.ExcludeAmbiguous {
+		for _, c := range effectiveAmbiguousChars(opts) {
+			set = strings.ReplaceAll(set, string(c), "")
+		}
+	}
+
+	return set, nil
+}
+
+// effectiveAmbiguousChars returns the set of characters ExcludeAmbiguous
+// strips: opts.AmbiguousChars if set, otherwise the package default.
+func effectiveAmbiguousChars(opts GeneratorOptions) string {
+	if opts.AmbiguousChars != "" {
+		return opts.AmbiguousChars
+	}
+	return ambiguousChars
+}
+
+// effectiveSymbols returns the symbol charset opts draws from: the
+// mobile-friendly subset if opts.MobileFriendly is set (which takes
+// precedence over SymbolSet), otherwise opts.SymbolSet if set, otherwise
+// the default symbol charset.
+func effectiveSymbols(opts GeneratorOptions) string {
+	if opts.MobileFriendly {
+		return mobileFriendlySymbols
+	}
+	if opts.SymbolSet != "" {
+		return opts.SymbolSet
+	}
+	return symbols
+}
+
+// fillConstrainedByte sets password[pos] to a random character from
+// charset, retrying against exceedsMaxRepeatAt so a forced position
+// constraint can never silently violate MaxRepeat against a neighbor that's
+// already been filled.
+func fillConstrainedByte(password []byte, pos int, charset string, maxRepeat int) error {
+	charsetLen := big.NewInt(int64(len(charset)))
+	for {
+		n, err := rand.Int(randReader, charsetLen)
+		if err != nil {
+			return err
+		}
+		c := charset[n.Int64()]
+		if exceedsMaxRepeatAt(password, pos, c, maxRepeat) {
+			continue
+		}
+		password[pos] = c
+		return nil
+	}
+}
+
+// maskBullet is the character used to render a masked password.
+const maskBullet = "•"
+
+// MaskFixed renders s as a fixed-width mask of width bullet characters,
+// regardless of len(s), so a rendered table column never leaks the real
+// password length. An empty s renders as width spaces instead.
+func MaskFixed(s string, width int) string {
+	if width < 0 {
+		width = 0
+	}
+	if s == "" {
+		return strings.Repeat(" ", width)
+	}
+	return strings.Repeat(maskBullet, width)
+}
+
 // CalculateStrength calculates the strength of a password.
 func CalculateStrength(password string) PasswordStrength {
 	if len(password) == 0 {
@@ -196,6 +436,10 @@ func CalculateStrength(password string) PasswordStrength {
 		}
 	}
 
+	if IsCommonPassword(password) {
+		score -= 2
+	}
+
 	switch {
 	case score <= 1:
 		return StrengthWeak
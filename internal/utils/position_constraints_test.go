@@ -0,0 +1,50 @@
+package utils
+
+import "testing"
+
+func TestPositionConstraintsHoldAcrossManyGenerations(t *testing.T) {
+	opts := DefaultGeneratorOptions()
+	opts.Length = 10
+	opts.PositionConstraints = PositionConstraints{FirstClass: ClassUpper, LastClass: ClassDigit}
+
+	for i := 0; i < 200; i++ {
+		pw, err := GeneratePassword(opts)
+		if err != nil {
+			t.Fatalf("GeneratePassword failed: %v", err)
+		}
+		if len(pw) != opts.Length {
+			t.Fatalf("Expected length %d, got %d", opts.Length, len(pw))
+		}
+		first := pw[0]
+		if first < 'A' || first > 'Z' {
+			t.Fatalf("Expected first character to be uppercase, got %q in %q", first, pw)
+		}
+		last := pw[len(pw)-1]
+		if last < '0' || last > '9' {
+			t.Fatalf("Expected last character to be a digit, got %q in %q", last, pw)
+		}
+	}
+}
+
+func TestPositionConstraintsRejectsDisabledClass(t *testing.T) {
+	opts := DefaultGeneratorOptions()
+	opts.IncludeDigits = false
+	opts.PositionConstraints = PositionConstraints{LastClass: ClassDigit}
+
+	if _, err := GeneratePassword(opts); err != ErrInvalidPositionConstraint {
+		t.Fatalf("Expected ErrInvalidPositionConstraint, got %v", err)
+	}
+}
+
+func TestPositionConstraintsClassAnyLeavesPositionUnconstrained(t *testing.T) {
+	opts := DefaultGeneratorOptions()
+	opts.Length = 8
+
+	pw, err := GeneratePassword(opts)
+	if err != nil {
+		t.Fatalf("GeneratePassword failed: %v", err)
+	}
+	if len(pw) != opts.Length {
+		t.Fatalf("Expected length %d, got %d", opts.Length, len(pw))
+	}
+}
@@ -0,0 +1,35 @@
+package utils
+
+import "os"
+
+// SecureTempFile creates a new temp file in dir (os.TempDir() if empty)
+// matching pattern, restricted to 0600 so exports and backups passing
+// through it aren't briefly world-readable.
+func SecureTempFile(dir, pattern string) (*os.File, error) {
+	if dir == "" {
+		dir = os.TempDir()
+	}
+
+	f, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.Chmod(0600); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// CleanupSecureTempFile truncates and removes a file created by
+// SecureTempFile, so no partial contents linger on disk after the caller is
+// done with it.
+func CleanupSecureTempFile(f *os.File) error {
+	path := f.Name()
+	_ = f.Truncate(0)
+	_ = f.Close()
+	return os.Remove(path)
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+func TestSecureTempFileCreatesFileWithRestrictivePermissions(t *testing.T) {
+	f, err := SecureTempFile(t.TempDir(), "export-*.tmp")
+	if err != nil {
+		t.Fatalf("SecureTempFile failed: %v", err)
+	}
+	defer CleanupSecureTempFile(f)
+
+	if runtime.GOOS == "windows" {
+		t.Skip("file mode bits are not meaningful on windows")
+	}
+
+	info, err := os.Stat(f.Name())
+	if err != nil {
+		t.Fatalf("Stat failed: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("Expected mode 0600, got %o", perm)
+	}
+}
+
+func TestCleanupSecureTempFileRemovesTheFile(t *testing.T) {
+	f, err := SecureTempFile(t.TempDir(), "export-*.tmp")
+	if err != nil {
+		t.Fatalf("SecureTempFile failed: %v", err)
+	}
+	path := f.Name()
+
+	if err := CleanupSecureTempFile(f); err != nil {
+		t.Fatalf("CleanupSecureTempFile failed: %v", err)
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected the temp file to be removed, stat error: %v", err)
+	}
+}
+
+func TestSecureTempFileDefaultsToOSTempDir(t *testing.T) {
+	f, err := SecureTempFile("", "export-*.tmp")
+	if err != nil {
+		t.Fatalf("SecureTempFile failed: %v", err)
+	}
+	defer CleanupSecureTempFile(f)
+
+	if dir := os.TempDir(); len(f.Name()) < len(dir) || f.Name()[:len(dir)] != dir {
+		t.Errorf("Expected the file to live under %q, got %q", dir, f.Name())
+	}
+}
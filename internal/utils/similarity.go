@@ -0,0 +1,72 @@
+package utils
+
+import "strings"
+
+// IsTrivialVariation reports whether newPw is a trivially weak variation of
+// oldPw: the same password with a case flip, an appended/removed trailing
+// digit, or a single-character edit. Used to warn (not block) on a password
+// change that doesn't meaningfully improve security.
+func IsTrivialVariation(oldPw, newPw string) bool {
+	if oldPw == newPw {
+		return true
+	}
+	if strings.EqualFold(oldPw, newPw) {
+		return true
+	}
+	if isAppendedDigitVariant(oldPw, newPw) || isAppendedDigitVariant(newPw, oldPw) {
+		return true
+	}
+
+	return levenshtein(oldPw, newPw) <= 1
+}
+
+// isAppendedDigitVariant reports whether longer is shorter with exactly one
+// trailing digit appended.
+func isAppendedDigitVariant(shorter, longer string) bool {
+	if len(longer) != len(shorter)+1 {
+		return false
+	}
+	if !strings.HasPrefix(longer, shorter) {
+		return false
+	}
+	last := longer[len(longer)-1]
+	return last >= '0' && last <= '9'
+}
+
+// levenshtein computes the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	m, n := len(ra), len(rb)
+
+	prev := make([]int, n+1)
+	curr := make([]int, n+1)
+	for j := 0; j <= n; j++ {
+		prev[j] = j
+	}
+
+	for i := 1; i <= m; i++ {
+		curr[0] = i
+		for j := 1; j <= n; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(curr[j-1]+1, prev[j]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[n]
+}
+
+// min3 returns the smallest of three ints.
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
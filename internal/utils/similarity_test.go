@@ -0,0 +1,30 @@
+package utils
+
+import "testing"
+
+func TestIsTrivialVariationAppendedDigit(t *testing.T) {
+	if !IsTrivialVariation("correcthorse", "correcthorse1") {
+		t.Error("Expected an appended trailing digit to be flagged as trivial")
+	}
+	if !IsTrivialVariation("correcthorse1", "correcthorse") {
+		t.Error("Expected removing a trailing digit to be flagged as trivial")
+	}
+}
+
+func TestIsTrivialVariationCaseFlip(t *testing.T) {
+	if !IsTrivialVariation("CorrectHorse", "correcthorse") {
+		t.Error("Expected a pure case flip to be flagged as trivial")
+	}
+}
+
+func TestIsTrivialVariationSingleInsertion(t *testing.T) {
+	if !IsTrivialVariation("correcthorse", "correcthorsex") {
+		t.Error("Expected a single inserted character to be flagged as trivial")
+	}
+}
+
+func TestIsTrivialVariationGenuinelyDifferentPassword(t *testing.T) {
+	if IsTrivialVariation("correcthorsebattery", "zxQ9!mPlantGiraffe7") {
+		t.Error("Expected a genuinely different password not to be flagged as trivial")
+	}
+}
@@ -0,0 +1,37 @@
+// Package utils provides utility functions for the password manager.
+package utils
+
+import "strings"
+
+// suggestedMinLength is the floor SuggestGeneratorOptions applies even if
+// the existing password is shorter, so a "quick-fix" regeneration doesn't
+// just reproduce another weak password.
+const suggestedMinLength = 16
+
+// SuggestGeneratorOptions infers generator options from an existing
+// password's apparent constraints, so a "weak password quick-fix" can
+// regenerate a stronger password without violating a site's character-class
+// requirements it can't otherwise detect (e.g. a site that rejects
+// symbols). Length is the greater of the existing password's length and
+// suggestedMinLength. A character class is enabled if the existing password
+// contains it, or if none of the classes match anything (e.g. an empty
+// password), in which case DefaultGeneratorOptions' classes are used.
+func SuggestGeneratorOptions(existing string) GeneratorOptions {
+	opts := GeneratorOptions{
+		Length:           suggestedMinLength,
+		IncludeLowercase: strings.ContainsAny(existing, lowercase),
+		IncludeUppercase: strings.ContainsAny(existing, uppercase),
+		IncludeDigits:    strings.ContainsAny(existing, digits),
+		IncludeSymbols:   strings.ContainsAny(existing, symbols),
+	}
+
+	if len(existing) > opts.Length {
+		opts.Length = len(existing)
+	}
+
+	if !opts.IncludeLowercase && !opts.IncludeUppercase && !opts.IncludeDigits && !opts.IncludeSymbols {
+		return DefaultGeneratorOptions()
+	}
+
+	return opts
+}
@@ -0,0 +1,42 @@
+package utils
+
+import "testing"
+
+func TestSuggestGeneratorOptionsDigitsOnlyExisting(t *testing.T) {
+	got := SuggestGeneratorOptions("48213697")
+
+	if !got.IncludeDigits {
+		t.Error("Expected digits to be enabled")
+	}
+	if got.IncludeLowercase || got.IncludeUppercase || got.IncludeSymbols {
+		t.Errorf("Expected only digits to be enabled, got %+v", got)
+	}
+	if got.Length < 16 {
+		t.Errorf("Expected the suggested length to be at least 16, got %d", got.Length)
+	}
+}
+
+func TestSuggestGeneratorOptionsAllClassesEnabled(t *testing.T) {
+	got := SuggestGeneratorOptions("Abc123!@#")
+
+	if !got.IncludeLowercase || !got.IncludeUppercase || !got.IncludeDigits || !got.IncludeSymbols {
+		t.Errorf("Expected every character class to be enabled, got %+v", got)
+	}
+}
+
+func TestSuggestGeneratorOptionsLengthGrowsWithLongerExisting(t *testing.T) {
+	got := SuggestGeneratorOptions("this-is-a-very-long-existing-password-1")
+
+	if got.Length < len("this-is-a-very-long-existing-password-1") {
+		t.Errorf("Expected the suggested length to cover the existing password's length, got %d", got.Length)
+	}
+}
+
+func TestSuggestGeneratorOptionsEmptyFallsBackToDefaults(t *testing.T) {
+	got := SuggestGeneratorOptions("")
+	want := DefaultGeneratorOptions()
+
+	if got != want {
+		t.Errorf("Expected an empty password to fall back to DefaultGeneratorOptions, got %+v", got)
+	}
+}
@@ -0,0 +1,49 @@
+package utils
+
+import "testing"
+
+func TestSymbolSetRestrictsGeneratedSymbols(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:         50,
+		IncludeSymbols: true,
+		SymbolSet:      "!@#$%",
+	}
+
+	pw, err := GeneratePassword(opts)
+	if err != nil {
+		t.Fatalf("GeneratePassword failed: %v", err)
+	}
+
+	for _, c := range pw {
+		if !contains(opts.SymbolSet, byte(c)) {
+			t.Fatalf("Expected every character to be in %q, found %q in %q", opts.SymbolSet, c, pw)
+		}
+	}
+}
+
+func TestSymbolSetEmptyUsesDefaults(t *testing.T) {
+	opts := GeneratorOptions{
+		Length:         50,
+		IncludeSymbols: true,
+	}
+
+	pw, err := GeneratePassword(opts)
+	if err != nil {
+		t.Fatalf("GeneratePassword failed: %v", err)
+	}
+
+	for _, c := range pw {
+		if !contains(symbols, byte(c)) {
+			t.Fatalf("Expected every character to be a default symbol, found %q in %q", c, pw)
+		}
+	}
+}
+
+func contains(set string, c byte) bool {
+	for i := 0; i < len(set); i++ {
+		if set[i] == c {
+			return true
+		}
+	}
+	return false
+}
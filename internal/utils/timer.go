@@ -6,21 +6,58 @@ import (
 	"time"
 )
 
+// LockMode selects how an AutoLockTimer's deadline responds to activity.
+type LockMode string
+
+const (
+	// LockModeIdle locks after the timeout elapses with no activity; Reset
+	// pushes the deadline back out. This is the default, pre-existing
+	// behavior.
+	LockModeIdle LockMode = "idle"
+	// LockModeAbsolute locks the timeout after unlock, regardless of
+	// activity; Reset is a no-op.
+	LockModeAbsolute LockMode = "absolute"
+)
+
 // AutoLockTimer manages the auto-lock timeout for the vault.
 type AutoLockTimer struct {
 	mu       sync.Mutex
 	timeout  time.Duration
-	timer    *time.Timer
+	timer    scheduledTimer
 	callback func()
 	active   bool
+	clock    clock
+	deadline time.Time
+	mode     LockMode
 }
 
-// NewAutoLockTimer creates a new auto-lock timer.
+// NewAutoLockTimer creates a new auto-lock timer in LockModeIdle.
 func NewAutoLockTimer(timeout time.Duration, callback func()) *AutoLockTimer {
 	return &AutoLockTimer{
 		timeout:  timeout,
 		callback: callback,
+		clock:    realClock{},
+		mode:     LockModeIdle,
+	}
+}
+
+// SetMode sets the timer's lock mode. An empty mode is treated as
+// LockModeIdle.
+func (t *AutoLockTimer) SetMode(mode LockMode) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if mode == "" {
+		mode = LockModeIdle
 	}
+	t.mode = mode
+}
+
+// SetClock overrides the clock used to schedule the timeout. Used in tests
+// to advance time deterministically instead of waiting on real time.
+func (t *AutoLockTimer) SetClock(c clock) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.clock = c
 }
 
 // Start starts the auto-lock timer.
@@ -33,22 +70,29 @@ func (t *AutoLockTimer) Start() {
 	}
 
 	if t.timeout > 0 {
-		t.timer = time.AfterFunc(t.timeout, t.onTimeout)
+		t.timer = t.clock.AfterFunc(t.timeout, t.onTimeout)
+		t.deadline = time.Now().Add(t.timeout)
 		t.active = true
 	}
 }
 
-// Reset resets the auto-lock timer (user activity).
+// Reset resets the auto-lock timer (user activity). In LockModeAbsolute,
+// activity doesn't extend the deadline, so Reset is a no-op.
 func (t *AutoLockTimer) Reset() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	if t.mode == LockModeAbsolute {
+		return
+	}
+
 	if t.timer != nil {
 		t.timer.Stop()
 	}
 
 	if t.timeout > 0 && t.active {
-		t.timer = time.AfterFunc(t.timeout, t.onTimeout)
+		t.timer = t.clock.AfterFunc(t.timeout, t.onTimeout)
+		t.deadline = time.Now().Add(t.timeout)
 	}
 }
 
@@ -75,7 +119,8 @@ func (t *AutoLockTimer) SetTimeout(timeout time.Duration) {
 	if t.active && t.timer != nil {
 		t.timer.Stop()
 		if timeout > 0 {
-			t.timer = time.AfterFunc(timeout, t.onTimeout)
+			t.timer = t.clock.AfterFunc(timeout, t.onTimeout)
+			t.deadline = time.Now().Add(timeout)
 		}
 	}
 }
@@ -100,7 +145,8 @@ func (t *AutoLockTimer) onTimeout() {
 	}
 }
 
-// Remaining returns the approximate time remaining before lock.
+// Remaining returns the time remaining before lock, based on the deadline
+// recorded at the last Start/Reset/SetTimeout.
 func (t *AutoLockTimer) Remaining() time.Duration {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -109,6 +155,9 @@ func (t *AutoLockTimer) Remaining() time.Duration {
 		return 0
 	}
 
-	// Note: This is an approximation since Go's timer doesn't expose remaining time
-	return t.timeout
+	remaining := time.Until(t.deadline)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
 }
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAutoLockTimerFiresOnceWhenClockAdvancesPastTimeout(t *testing.T) {
+	fc := newFakeClock()
+	fired := 0
+	timer := NewAutoLockTimer(5*time.Second, func() { fired++ })
+	timer.SetClock(fc)
+
+	timer.Start()
+	fc.Advance(4 * time.Second)
+	if fired != 0 {
+		t.Fatalf("Expected callback not to fire before the timeout, fired %d times", fired)
+	}
+
+	fc.Advance(1 * time.Second)
+	if fired != 1 {
+		t.Fatalf("Expected callback to fire exactly once after the timeout, fired %d times", fired)
+	}
+
+	fc.Advance(10 * time.Second)
+	if fired != 1 {
+		t.Fatalf("Expected callback not to fire again on further advances, fired %d times", fired)
+	}
+}
+
+func TestAutoLockTimerResetPushesDeadlineBackInIdleMode(t *testing.T) {
+	fc := newFakeClock()
+	fired := 0
+	timer := NewAutoLockTimer(5*time.Second, func() { fired++ })
+	timer.SetClock(fc)
+
+	timer.Start()
+	fc.Advance(3 * time.Second)
+	timer.Reset()
+	fc.Advance(3 * time.Second)
+	if fired != 0 {
+		t.Fatalf("Expected Reset to push the deadline back, fired %d times", fired)
+	}
+
+	fc.Advance(2 * time.Second)
+	if fired != 1 {
+		t.Fatalf("Expected callback to fire once the reset deadline elapses, fired %d times", fired)
+	}
+}
+
+func TestAutoLockTimerResetIsNoOpInAbsoluteMode(t *testing.T) {
+	fc := newFakeClock()
+	fired := 0
+	timer := NewAutoLockTimer(5*time.Second, func() { fired++ })
+	timer.SetClock(fc)
+	timer.SetMode(LockModeAbsolute)
+
+	timer.Start()
+	fc.Advance(3 * time.Second)
+	timer.Reset()
+	fc.Advance(2 * time.Second)
+	if fired != 1 {
+		t.Fatalf("Expected Reset to be a no-op in absolute mode, fired %d times", fired)
+	}
+}
+
+func TestAutoLockTimerStopCancelsPendingTimeout(t *testing.T) {
+	fc := newFakeClock()
+	fired := 0
+	timer := NewAutoLockTimer(5*time.Second, func() { fired++ })
+	timer.SetClock(fc)
+
+	timer.Start()
+	timer.Stop()
+	fc.Advance(10 * time.Second)
+
+	if fired != 0 {
+		t.Fatalf("Expected Stop to cancel the pending timeout, fired %d times", fired)
+	}
+	if timer.IsActive() {
+		t.Fatal("Expected IsActive to be false after Stop")
+	}
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// NormalizeURL cleans up a user-entered entry URL: it defaults to the https
+// scheme when none is given, lowercases the host, and strips the port when
+// it's the scheme's default. The path and query are preserved as-is.
+func NormalizeURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return raw
+	}
+
+	if _, ok := explicitScheme(raw); !ok {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if port != "" && !isDefaultPort(u.Scheme, port) {
+		host = host + ":" + port
+	}
+	u.Host = host
+
+	return u.String()
+}
+
+// explicitScheme reports the URI scheme raw starts with, if any, e.g.
+// "javascript:alert(1)" -> ("javascript", true). A leading "host:port" like
+// "localhost:8080" or "example.com:8443/path" is not a scheme: the part
+// between the colon and the first slash (or the end of the string) is a bare
+// port number, not scheme-specific data.
+func explicitScheme(raw string) (string, bool) {
+	colon := strings.IndexByte(raw, ':')
+	if colon <= 0 {
+		return "", false
+	}
+
+	scheme := raw[:colon]
+	for i, c := range scheme {
+		switch {
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '+' || c == '-' || c == '.'):
+		default:
+			return "", false
+		}
+	}
+
+	rest := raw[colon+1:]
+	beforeSlash := rest
+	if i := strings.IndexByte(rest, '/'); i >= 0 {
+		beforeSlash = rest[:i]
+	}
+	if beforeSlash != "" && isAllDigits(beforeSlash) {
+		return "", false
+	}
+
+	return strings.ToLower(scheme), true
+}
+
+// isAllDigits reports whether s is non-empty and consists only of digits.
+func isAllDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isDefaultPort reports whether port is the default port for scheme.
+func isDefaultPort(scheme, port string) bool {
+	switch scheme {
+	case "https":
+		return port == "443"
+	case "http":
+		return port == "80"
+	default:
+		return false
+	}
+}
@@ -0,0 +1,49 @@
+package utils
+
+import "testing"
+
+func TestNormalizeURLAddsDefaultScheme(t *testing.T) {
+	if got := NormalizeURL("github.com"); got != "https://github.com" {
+		t.Errorf("Expected scheme-less input to get https://, got %q", got)
+	}
+}
+
+func TestNormalizeURLLowercasesHost(t *testing.T) {
+	if got := NormalizeURL("https://GitHub.COM/user"); got != "https://github.com/user" {
+		t.Errorf("Expected the host to be lowercased, got %q", got)
+	}
+}
+
+func TestNormalizeURLStripsDefaultPort(t *testing.T) {
+	if got := NormalizeURL("https://example.com:443/path"); got != "https://example.com/path" {
+		t.Errorf("Expected the default https port to be stripped, got %q", got)
+	}
+	if got := NormalizeURL("http://example.com:80"); got != "http://example.com" {
+		t.Errorf("Expected the default http port to be stripped, got %q", got)
+	}
+}
+
+func TestNormalizeURLKeepsNonDefaultPort(t *testing.T) {
+	if got := NormalizeURL("https://example.com:8443/path"); got != "https://example.com:8443/path" {
+		t.Errorf("Expected a non-default port to be preserved, got %q", got)
+	}
+}
+
+func TestNormalizeURLLeavesAlreadyNormalizedURLUnchanged(t *testing.T) {
+	want := "https://example.com/path?query=1"
+	if got := NormalizeURL(want); got != want {
+		t.Errorf("Expected an already-normalized URL to be unchanged, got %q", got)
+	}
+}
+
+func TestNormalizeURLDoesNotMistakeHostPortForAScheme(t *testing.T) {
+	if got := NormalizeURL("localhost:8080"); got != "https://localhost:8080" {
+		t.Errorf("Expected a bare host:port to get the default scheme, got %q", got)
+	}
+}
+
+func TestNormalizeURLLeavesNonHTTPSchemesAlone(t *testing.T) {
+	if got := NormalizeURL("javascript:alert(1)"); got != "javascript:alert(1)" {
+		t.Errorf("Expected a non-URL scheme not to be mistaken for a bare host, got %q", got)
+	}
+}
@@ -0,0 +1,33 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
+)
+
+func TestAnnotateStrengthMatchesManualTally(t *testing.T) {
+	weak := NewEntry("Weak")
+	weak.Password = "abc"
+	strong := NewEntry("Strong")
+	strong.Password = "Tr0ub4dor&3!ExtraLength"
+
+	entries := EntryList{weak, strong}
+	entries.AnnotateStrength()
+
+	weakCount := 0
+	for _, e := range entries {
+		if e.Strength == utils.StrengthWeak {
+			weakCount++
+		}
+	}
+	if weakCount != 1 {
+		t.Errorf("Expected 1 weak entry, got %d", weakCount)
+	}
+	if weak.Strength != utils.CalculateStrength(weak.Password) {
+		t.Errorf("Expected weak entry's Strength to match CalculateStrength")
+	}
+	if strong.Strength == utils.StrengthWeak {
+		t.Errorf("Expected strong entry not to be tagged weak")
+	}
+}
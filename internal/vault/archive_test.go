@@ -0,0 +1,45 @@
+package vault
+
+import "testing"
+
+func TestEntryArchiveUnarchive(t *testing.T) {
+	e := NewEntry("Site")
+
+	e.Archive()
+	if !e.IsArchived {
+		t.Error("Expected entry to be archived")
+	}
+
+	e.Unarchive()
+	if e.IsArchived {
+		t.Error("Expected entry to be unarchived")
+	}
+}
+
+func TestEntryListArchiveByID(t *testing.T) {
+	el := EntryList{NewEntry("A"), NewEntry("B")}
+
+	if !el.Archive(el[0].ID) {
+		t.Fatal("Expected Archive to find entry by ID")
+	}
+	if el.Archive("missing") {
+		t.Error("Expected Archive to return false for unknown ID")
+	}
+
+	active := el.Active()
+	if len(active) != 1 || active[0].Title != "B" {
+		t.Errorf("Expected only 'B' in Active(), got %+v", active)
+	}
+
+	archived := el.Archived()
+	if len(archived) != 1 || archived[0].Title != "A" {
+		t.Errorf("Expected only 'A' in Archived(), got %+v", archived)
+	}
+
+	if !el.Unarchive(el[0].ID) {
+		t.Fatal("Expected Unarchive to find entry by ID")
+	}
+	if len(el.Active()) != 2 {
+		t.Error("Expected both entries active after Unarchive")
+	}
+}
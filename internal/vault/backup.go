@@ -2,6 +2,7 @@
 package vault
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -99,6 +100,122 @@ func (v *Vault) ExportEncryptedBackup(backupPath string) error {
 	return nil
 }
 
+// ExportBackupWithPassword creates a portable encrypted backup protected by
+// a standalone password (independent of the vault's live master password).
+// Its key is derived purely from that password plus a freshly generated
+// salt and the current KDFConfig, both embedded in the backup header —
+// unlike the live vault, it never involves the OS keyring, so the backup
+// restores with ImportBackupWithPassword on any machine regardless of that
+// machine's keyring contents. Use ExportEncryptedBackup/
+// RestoreFromBackupWithPhrase for the recovery-phrase-only backup instead.
+func (v *Vault) ExportBackupWithPassword(backupPath, password string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.modified {
+		if err := v.saveLocked(); err != nil {
+			return fmt.Errorf("failed to save vault before backup: %w", err)
+		}
+	}
+
+	dir := filepath.Dir(backupPath)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	salt, err := crypto.GenerateSalt()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBackupFailed, err)
+	}
+	key := crypto.DeriveKey([]byte(password), salt)
+	defer crypto.ZeroMemory(key)
+
+	mekBytes, mekCleanup, err := v.mek.Bytes()
+	if err != nil {
+		return err
+	}
+	defer mekCleanup()
+
+	encMEK, err := crypto.EncryptMEK(mekBytes, key)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBackupFailed, err)
+	}
+
+	backupDB := &Database{
+		Version:              v.db.Version,
+		SecurityMode:         SecurityModePasswordOnly,
+		KDF:                  CurrentKDFConfig(),
+		SaltPassword:         hex.EncodeToString(salt),
+		EncryptedMEKPassword: hex.EncodeToString(encMEK),
+	}
+
+	dataJSON, err := json.Marshal(v.data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal data: %w", err)
+	}
+	defer crypto.ZeroMemory(dataJSON)
+
+	aad := backupDB.BuildAAD()
+	encData, err := crypto.EncryptWithAAD(dataJSON, mekBytes, aad)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt data: %w", err)
+	}
+	backupDB.SetEncryptedData(encData)
+
+	if err := SaveDatabase(backupDB, backupPath); err != nil {
+		return fmt.Errorf("%w: %v", ErrBackupFailed, err)
+	}
+
+	now := time.Now()
+	v.db.SetLastBackup(now)
+	if err := v.saveLocked(); err != nil {
+		return fmt.Errorf("failed to update backup timestamp: %w", err)
+	}
+
+	return nil
+}
+
+// ImportBackupWithPassword decrypts a backup created by
+// ExportBackupWithPassword using the standalone backup password, returning
+// the entries it contains. It derives its key the same way the export did
+// (password + embedded salt + embedded KDFConfig) and never touches the
+// keyring, so it works regardless of which keyring (if any) is present on
+// this machine.
+func ImportBackupWithPassword(backupPath, password string) (EntryList, error) {
+	db, err := LoadDatabase(backupPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+
+	salt, err := db.GetSaltPassword()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+	key := crypto.DeriveKey([]byte(password), salt)
+	defer crypto.ZeroMemory(key)
+
+	encMEK, err := db.GetEncryptedMEKPassword()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+
+	mekBytes, err := crypto.DecryptMEK(encMEK, key)
+	if err != nil {
+		if errors.Is(err, crypto.ErrMEKDecryptionFailed) {
+			return nil, ErrWrongPassword
+		}
+		return nil, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+	defer crypto.ZeroMemory(mekBytes)
+
+	vaultData, err := decryptVaultData(db, mekBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+
+	return vaultData.Entries, nil
+}
+
 // ExportPlaintext exports the vault as unencrypted JSON.
 // WARNING: This creates an unencrypted file. Use with extreme caution.
 func (v *Vault) ExportPlaintext(path string) error {
@@ -180,6 +297,50 @@ func VerifyBackupWithPhrase(backupPath, phrase string) error {
 	return nil
 }
 
+// VerifyBackup is a dry-run check that a backup stream actually decrypts:
+// it reads the backup, decrypts its header and every entry using the
+// recovery phrase (backups are phrase-only, per ExportEncryptedBackup), and
+// returns the entry count without returning any plaintext. Decrypted
+// material is zeroed before returning.
+func VerifyBackup(r io.Reader, phrase string) (entryCount int, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+
+	var db Database
+	if err := json.Unmarshal(data, &db); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+
+	phraseKey, err := crypto.PhraseToKey(phrase)
+	if err != nil {
+		return 0, ErrWrongPhrase
+	}
+	defer crypto.ZeroMemory(phraseKey)
+
+	encMEK, err := db.GetEncryptedMEKPhrase()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+
+	mekBytes, err := crypto.DecryptMEK(encMEK, phraseKey)
+	if err != nil {
+		if errors.Is(err, crypto.ErrMEKDecryptionFailed) {
+			return 0, ErrWrongPhrase
+		}
+		return 0, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+
+	vaultData, err := decryptVaultData(&db, mekBytes)
+	crypto.ZeroMemory(mekBytes)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+
+	return len(vaultData.Entries), nil
+}
+
 // copyFile copies a file from src to dst.
 func copyFile(src, dst string) error {
 	srcFile, err := os.Open(src)
@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveBacksUpPreviousContentsWhenEnabled(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+	password := "password"
+
+	v, _, err := Create(vaultPath, password)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	v.SetBackupBeforeSave(true)
+
+	if _, err := os.Stat(vaultPath + backupSuffix); !os.IsNotExist(err) {
+		t.Fatalf("Expected no backup file before any save, stat err: %v", err)
+	}
+
+	original, err := os.ReadFile(vaultPath)
+	if err != nil {
+		t.Fatalf("Failed to read vault file: %v", err)
+	}
+
+	entry := NewEntry("Entry 1")
+	entry.Password = "pass1"
+	v.AddEntry(entry)
+
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	backup, err := os.ReadFile(vaultPath + backupSuffix)
+	if err != nil {
+		t.Fatalf("Expected backup file after save, got error: %v", err)
+	}
+	if string(backup) != string(original) {
+		t.Error("Expected backup to contain the previous vault contents")
+	}
+}
+
+func TestSaveSkipsBackupWhenDisabled(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	if _, err := os.Stat(vaultPath + backupSuffix); !os.IsNotExist(err) {
+		t.Errorf("Expected no backup file when disabled, stat err: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+func TestExportBackupWithPasswordRestoresAfterKeyringSwap(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+	backupPath := filepath.Join(tmpDir, "backup.vault")
+
+	v, _, err := Create(vaultPath, "vault-password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	v.AddEntry(NewEntry("Entry 1"))
+	v.AddEntry(NewEntry("Entry 2"))
+	v.Save()
+
+	if err := v.ExportBackupWithPassword(backupPath, "backup-password"); err != nil {
+		t.Fatalf("ExportBackupWithPassword failed: %v", err)
+	}
+	v.Lock()
+
+	// Simulate restoring on a different machine with an empty keyring.
+	_, cleanup := crypto.UseMockKeyring()
+	defer cleanup()
+
+	entries, err := ImportBackupWithPassword(backupPath, "backup-password")
+	if err != nil {
+		t.Fatalf("ImportBackupWithPassword failed after keyring swap: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+}
+
+func TestImportBackupWithPasswordWrongPasswordFails(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+	backupPath := filepath.Join(tmpDir, "backup.vault")
+
+	v, _, err := Create(vaultPath, "vault-password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	v.Save()
+
+	if err := v.ExportBackupWithPassword(backupPath, "backup-password"); err != nil {
+		t.Fatalf("ExportBackupWithPassword failed: %v", err)
+	}
+	v.Lock()
+
+	if _, err := ImportBackupWithPassword(backupPath, "wrong-password"); err != ErrWrongPassword {
+		t.Fatalf("Expected ErrWrongPassword, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+func TestVerifyBackupValid(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+	backupPath := filepath.Join(tmpDir, "backup.vault")
+
+	v, phrase, _ := Create(vaultPath, "password")
+	v.AddEntry(NewEntry("Entry 1"))
+	v.AddEntry(NewEntry("Entry 2"))
+	v.Save()
+
+	if err := v.ExportEncryptedBackup(backupPath); err != nil {
+		t.Fatalf("ExportEncryptedBackup failed: %v", err)
+	}
+	v.Lock()
+
+	f, err := os.Open(backupPath)
+	if err != nil {
+		t.Fatalf("Failed to open backup: %v", err)
+	}
+	defer f.Close()
+
+	count, err := VerifyBackup(f, phrase)
+	if err != nil {
+		t.Fatalf("VerifyBackup failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 entries, got %d", count)
+	}
+}
+
+func TestVerifyBackupWrongPhrase(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+	backupPath := filepath.Join(tmpDir, "backup.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	v.Save()
+	if err := v.ExportEncryptedBackup(backupPath); err != nil {
+		t.Fatalf("ExportEncryptedBackup failed: %v", err)
+	}
+	v.Lock()
+
+	f, err := os.Open(backupPath)
+	if err != nil {
+		t.Fatalf("Failed to open backup: %v", err)
+	}
+	defer f.Close()
+
+	otherPhrase, err := crypto.GenerateRecoveryPhrase()
+	if err != nil {
+		t.Fatalf("Failed to generate phrase: %v", err)
+	}
+
+	if _, err := VerifyBackup(f, otherPhrase); err == nil {
+		t.Fatal("Expected an error for the wrong recovery phrase")
+	}
+}
+
+func TestVerifyBackupCorrupt(t *testing.T) {
+	_, err := VerifyBackup(bytes.NewReader([]byte("not valid json")), "whatever")
+	if err == nil {
+		t.Fatal("Expected an error for corrupt backup data")
+	}
+	if !errors.Is(err, ErrBackupInvalid) {
+		t.Errorf("Expected ErrBackupInvalid wrapping, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package vault
+
+import "time"
+
+// BreachInfo records the result of the most recent breach check (e.g.
+// against Have I Been Pwned) for an entry's password. It deliberately
+// stores only a count and a timestamp — never the password, a hash of it,
+// or any other derivable secret — so the vault doesn't need to recheck on
+// every view and a UI can show "found in breaches (checked 2 days ago)".
+type BreachInfo struct {
+	// Count is the number of times the password appeared in known breaches.
+	Count int `json:"count"`
+	// CheckedAt is when this result was obtained.
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// SetBreachInfo records the result of a breach check for e. A nil Breach
+// (the zero value) means "never checked", distinct from a check that found
+// zero breaches.
+func (e *Entry) SetBreachInfo(count int, checkedAt time.Time) {
+	e.Breach = &BreachInfo{Count: count, CheckedAt: checkedAt}
+}
+
+// BreachInfo returns e's most recent breach check result, or nil if it has
+// never been checked.
+func (e *Entry) BreachInfo() *BreachInfo {
+	return e.Breach
+}
+
+// BreachInfoStale reports whether e's breach info is missing or older than
+// maxAge as of now, meaning it should be recomputed before being trusted.
+func (e *Entry) BreachInfoStale(maxAge time.Duration, now time.Time) bool {
+	if e.Breach == nil {
+		return true
+	}
+	return now.Sub(e.Breach.CheckedAt) > maxAge
+}
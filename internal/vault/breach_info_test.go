@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBreachInfoSetAndGet(t *testing.T) {
+	e := NewEntry("Example")
+
+	if e.BreachInfo() != nil {
+		t.Fatal("Expected BreachInfo to be nil before any check")
+	}
+
+	checkedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	e.SetBreachInfo(3, checkedAt)
+
+	info := e.BreachInfo()
+	if info == nil {
+		t.Fatal("Expected non-nil BreachInfo after SetBreachInfo")
+	}
+	if info.Count != 3 {
+		t.Fatalf("Expected Count 3, got %d", info.Count)
+	}
+	if !info.CheckedAt.Equal(checkedAt) {
+		t.Fatalf("Expected CheckedAt %v, got %v", checkedAt, info.CheckedAt)
+	}
+}
+
+func TestBreachInfoStale(t *testing.T) {
+	e := NewEntry("Example")
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	if !e.BreachInfoStale(24*time.Hour, now) {
+		t.Fatal("Expected never-checked info to be stale")
+	}
+
+	e.SetBreachInfo(0, now.Add(-2*time.Hour))
+	if e.BreachInfoStale(24*time.Hour, now) {
+		t.Fatal("Expected recent info to not be stale")
+	}
+
+	e.SetBreachInfo(0, now.Add(-48*time.Hour))
+	if !e.BreachInfoStale(24*time.Hour, now) {
+		t.Fatal("Expected info older than maxAge to be stale")
+	}
+}
@@ -0,0 +1,69 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+// Compact rewrites the vault at path, re-encrypting its current entries and
+// atomically replacing the file. Because saves already store only the live
+// entries (there's no append-only log or per-entry dead space to reclaim),
+// this mainly shrinks a file that grew from now-superseded encrypted blobs
+// left behind by an interrupted save; it's a no-op (reclaimedBytes 0) when
+// the rewrite doesn't shrink the file.
+func Compact(path string, mek []byte) (reclaimedBytes int64, err error) {
+	before, err := os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to stat vault: %w", err)
+	}
+
+	db, err := LoadDatabase(path)
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := decryptVaultData(db, mek)
+	if err != nil {
+		return 0, err
+	}
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return 0, err
+	}
+	defer crypto.ZeroMemory(dataJSON)
+
+	aad := db.BuildAAD()
+	encData, err := crypto.EncryptWithAAD(dataJSON, mek, aad)
+	if err != nil {
+		return 0, err
+	}
+	db.SetEncryptedData(encData)
+
+	tmp := path + ".compact.tmp"
+	marshaled, err := json.MarshalIndent(db, "", "  ")
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal database: %w", err)
+	}
+	if err := os.WriteFile(tmp, marshaled, 0600); err != nil {
+		return 0, fmt.Errorf("failed to write compacted vault: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return 0, fmt.Errorf("failed to finalize compacted vault: %w", err)
+	}
+
+	after, err := os.Stat(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to stat compacted vault: %w", err)
+	}
+
+	reclaimed := before.Size() - after.Size()
+	if reclaimed < 0 {
+		reclaimed = 0
+	}
+	return reclaimed, nil
+}
@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCompactPreservesEntries(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	v.AddEntry(NewEntry("Entry 1"))
+	v.AddEntry(NewEntry("Entry 2"))
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	mekBytes, cleanup, err := v.mek.Bytes()
+	if err != nil {
+		t.Fatalf("failed to read MEK: %v", err)
+	}
+	defer cleanup()
+
+	if _, err := Compact(vaultPath, mekBytes); err != nil {
+		t.Fatalf("Compact failed: %v", err)
+	}
+
+	v.Lock()
+
+	reopened, err := UnlockWithPassword(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword after compact failed: %v", err)
+	}
+	if reopened.EntryCount() != 2 {
+		t.Errorf("Expected 2 entries after compact, got %d", reopened.EntryCount())
+	}
+}
+
+func TestCompactNoOpWhenFileDoesNotShrink(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	mekBytes, cleanup, err := v.mek.Bytes()
+	if err != nil {
+		t.Fatalf("failed to read MEK: %v", err)
+	}
+	defer cleanup()
+
+	reclaimed, err := Compact(vaultPath, mekBytes)
+	if err != nil {
+		t.Fatalf("Compact failed: %v", err)
+	}
+	if reclaimed < 0 {
+		t.Errorf("Expected non-negative reclaimedBytes, got %d", reclaimed)
+	}
+}
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"errors"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
+)
+
+// FieldKind identifies which part of an entry to copy to the clipboard.
+type FieldKind int
+
+const (
+	FieldPassword FieldKind = iota
+	FieldUsername
+	FieldURL
+	FieldTOTP
+)
+
+// ErrFieldEmpty is returned when the requested field has no value to copy.
+var ErrFieldEmpty = errors.New("field has no value to copy")
+
+// CopyField resolves the requested field on e (generating a live TOTP code
+// if needed) and copies it via cm, starting its auto-clear countdown. This
+// centralizes the copy-then-clear flow so the UI doesn't duplicate it per
+// field.
+func CopyField(cm *utils.ClipboardManager, e *Entry, field FieldKind) (<-chan bool, error) {
+	var value string
+
+	switch field {
+	case FieldPassword:
+		value = e.Password
+	case FieldUsername:
+		value = e.Username
+	case FieldURL:
+		value = e.URL
+	case FieldTOTP:
+		code, _, err := e.GenerateTOTPCode()
+		if err != nil {
+			return nil, err
+		}
+		value = code
+	}
+
+	if value == "" {
+		return nil, ErrFieldEmpty
+	}
+
+	return cm.CopyWithNotification(value)
+}
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"testing"
+	"time"
+
+	"github.com/atotto/clipboard"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+	"github.com/batterdaysahead/cipher0/internal/utils"
+)
+
+func TestCopyFieldTOTP(t *testing.T) {
+	cm := utils.NewClipboardManager(time.Second)
+
+	entry := NewEntry("Test Entry")
+	entry.TOTPSecret = "JBSWY3DPEHPK3PXP"
+
+	expectedCode, _, err := totp.GenerateCode(entry.TOTPSecret)
+	if err != nil {
+		t.Fatalf("GenerateCode failed: %v", err)
+	}
+
+	ch, err := CopyField(cm, entry, FieldTOTP)
+	if err != nil {
+		t.Skipf("Clipboard not available: %v", err)
+	}
+	if ch == nil {
+		t.Error("Expected a non-nil countdown channel")
+	}
+
+	copied, err := clipboard.ReadAll()
+	if err != nil {
+		t.Skipf("Clipboard not available: %v", err)
+	}
+	if copied != expectedCode {
+		t.Errorf("Expected clipboard to contain %q, got %q", expectedCode, copied)
+	}
+}
+
+func TestCopyFieldEmptyValue(t *testing.T) {
+	cm := utils.NewClipboardManager(time.Second)
+	entry := NewEntry("Test Entry")
+
+	_, err := CopyField(cm, entry, FieldUsername)
+	if err != ErrFieldEmpty {
+		t.Errorf("Expected ErrFieldEmpty, got %v", err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/batterdaysahead/cipher0/internal/config"
 	"github.com/batterdaysahead/cipher0/internal/crypto"
 )
 
@@ -21,15 +22,23 @@ var (
 	ErrDatabaseCorrupted = errors.New("vault database is corrupted")
 	// ErrInvalidVersion is returned when the database version is not supported.
 	ErrInvalidVersion = errors.New("unsupported database version")
+	// ErrIncompatibleVault is returned when the database's salts are not the
+	// expected length, indicating it was produced by an incompatible version.
+	ErrIncompatibleVault = errors.New("vault is incompatible with this version")
 )
 
 // DatabaseVersion is the current version of the database format.
 const DatabaseVersion = "1.1"
 
 // SecurityModePasswordKeyring indicates password + keyring security mode.
-// This is the default and only supported mode.
+// This is the default and only supported mode for live vaults.
 const SecurityModePasswordKeyring = "password_keyring"
 
+// SecurityModePasswordOnly indicates a vault whose MEK is wrapped purely by
+// a password-derived key, with no keyring involved. Used by
+// ExportBackupWithPassword so portable backups restore on any machine.
+const SecurityModePasswordOnly = "password_only"
+
 // KDFParams contains the parameters for the key derivation function.
 type KDFParams struct {
 	// Memory is the memory usage in KB.
@@ -68,15 +77,62 @@ type Database struct {
 	EncryptedMEKPassword string `json:"encrypted_mek_password"`
 	// EncryptedMEKPhrase is the MEK encrypted with the recovery phrase-derived key.
 	EncryptedMEKPhrase string `json:"encrypted_mek_phrase"`
+	// EncryptedKeyringSecret, when set, holds the keyring secret encrypted
+	// with the phrase-derived key (see crypto.CreateMEKBundleWithKeyringBackup
+	// and Vault.RecoverKeyringSecret), so a reinstall that wipes the OS
+	// keyring can be recovered from the phrase alone instead of requiring a
+	// full backup restore. Empty for vaults created without that option.
+	EncryptedKeyringSecret string `json:"encrypted_keyring_secret,omitempty"`
 	// EncryptedData is the encrypted vault data (entries).
 	EncryptedData string `json:"encrypted_data"`
+	// SaltDuress, EncryptedMEKDuress, and EncryptedDataDuress wrap a second,
+	// independent vault under a duress password for plausible deniability
+	// (see crypto.CreateDuressBundle, Vault.SetDuressPassword, and
+	// UnlockWithPassword). Deliberately NOT omitempty and always populated,
+	// with indistinguishable random filler when no duress password is
+	// configured, so a vault file's shape never reveals whether a real
+	// duress password exists underneath -- see newDuressFields.
+	SaltDuress          string `json:"salt_duress"`
+	EncryptedMEKDuress  string `json:"encrypted_mek_duress"`
+	EncryptedDataDuress string `json:"encrypted_data_duress"`
 	// LastBackup is the timestamp of the last backup.
 	LastBackup *time.Time `json:"last_backup,omitempty"`
+	// Meta holds diagnostic header metadata (creation time, app version,
+	// last-modified time), readable without the MEK via ReadMeta. Nil for
+	// vaults created before this field existed.
+	Meta *VaultMeta `json:"meta,omitempty"`
+}
+
+// VaultMeta is unencrypted diagnostic metadata about when and by which app
+// version a vault was created, for migration decisions and bug reports
+// without requiring the master password. Deliberately excluded from
+// BuildAAD's header fields since LastModified changes on every save, and
+// the AAD must stay fixed for EncryptedData to decrypt. See ReadMeta.
+type VaultMeta struct {
+	// CreatedAt is when the vault was first created.
+	CreatedAt time.Time `json:"created_at"`
+	// CreatedByVersion is config.AppVersion at creation time.
+	CreatedByVersion string `json:"created_by_version"`
+	// LastModified is bumped on every successful save (see Vault.saveLocked).
+	LastModified time.Time `json:"last_modified"`
 }
 
-// NewDatabase creates a new database with the given MEK bundle and encrypted data.
-func NewDatabase(saltPassword, saltPhrase, encMEKPassword, encMEKPhrase, encData []byte, securityMode, keyringFingerprint string) *Database {
-	return &Database{
+// NewDatabase creates a new database with the given MEK bundle and encrypted
+// data. saltDuress/encMEKDuress/encDataDuress carry a real duress slot when
+// the caller is building one (see NewDuressDatabase); pass nil for all three
+// otherwise and newDuressFields fills them with indistinguishable random
+// filler, so a plain vault's file shape matches a duress-configured one.
+// encKeyringSecret is the bundle's optional backed-up keyring secret (see
+// crypto.CreateMEKBundleWithKeyringBackup); pass nil if the bundle wasn't
+// created with that option.
+func NewDatabase(saltPassword, saltPhrase, encMEKPassword, encMEKPhrase, encData, saltDuress, encMEKDuress, encDataDuress, encKeyringSecret []byte, securityMode, keyringFingerprint string) (*Database, error) {
+	saltDuress, encMEKDuress, encDataDuress, err := newDuressFields(saltDuress, encMEKDuress, encDataDuress)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	db := &Database{
 		Version:              DatabaseVersion,
 		SecurityMode:         securityMode,
 		KDF:                  CurrentKDFConfig(),
@@ -86,7 +142,97 @@ func NewDatabase(saltPassword, saltPhrase, encMEKPassword, encMEKPhrase, encData
 		EncryptedMEKPassword: hex.EncodeToString(encMEKPassword),
 		EncryptedMEKPhrase:   hex.EncodeToString(encMEKPhrase),
 		EncryptedData:        hex.EncodeToString(encData),
+		SaltDuress:           hex.EncodeToString(saltDuress),
+		EncryptedMEKDuress:   hex.EncodeToString(encMEKDuress),
+		EncryptedDataDuress:  hex.EncodeToString(encDataDuress),
+		Meta: &VaultMeta{
+			CreatedAt:        now,
+			CreatedByVersion: config.AppVersion,
+			LastModified:     now,
+		},
+	}
+	if len(encKeyringSecret) > 0 {
+		db.EncryptedKeyringSecret = hex.EncodeToString(encKeyringSecret)
 	}
+	return db, nil
+}
+
+// encryptedMEKDuressLength is the fixed byte length of an Encrypt()ed MEK --
+// a NonceSize-byte nonce, the MEKSize-byte plaintext, and a 16-byte GCM tag
+// -- mirroring crypto's own encryptedMEKLength. Used to size random filler
+// for an unconfigured EncryptedMEKDuress so it's the same shape as a real one.
+const encryptedMEKDuressLength = crypto.NonceSize + crypto.MEKSize + 16
+
+// newDuressFields fills in any of saltDuress/encMEKDuress/encDataDuress that
+// are empty with indistinguishable random filler, so a database built
+// without a duress password still has a full, correctly-shaped duress slot.
+// A caller setting up a real duress password (NewDuressDatabase) passes all
+// three already populated and gets them back unchanged.
+func newDuressFields(saltDuress, encMEKDuress, encDataDuress []byte) ([]byte, []byte, []byte, error) {
+	var err error
+	if len(saltDuress) == 0 {
+		if saltDuress, err = crypto.GenerateSalt(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if len(encMEKDuress) == 0 {
+		if encMEKDuress, err = crypto.GenerateRandomBytes(encryptedMEKDuressLength); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if len(encDataDuress) == 0 {
+		if encDataDuress, err = fillerEncryptedDuressData(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return saltDuress, encMEKDuress, encDataDuress, nil
+}
+
+// fillerEncryptedDuressData encrypts an empty VaultData under a throwaway,
+// immediately-discarded key, producing a ciphertext the same shape a freshly
+// created (and therefore also still-empty) decoy vault would have -- so
+// EncryptedDataDuress looks identical whether or not a duress password is
+// actually configured.
+func fillerEncryptedDuressData() ([]byte, error) {
+	emptyData, err := json.Marshal(&VaultData{Entries: make(EntryList, 0)})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := crypto.GenerateRandomBytes(crypto.MEKSize)
+	if err != nil {
+		return nil, err
+	}
+	defer crypto.ZeroMemory(key)
+
+	return crypto.EncryptWithAAD(emptyData, key, nil)
+}
+
+// TouchMeta bumps Meta.LastModified to now, creating Meta (with a zero
+// CreatedAt/CreatedByVersion) if db predates this field. Called by
+// Vault.saveLocked on every successful save.
+func (db *Database) TouchMeta() {
+	if db.Meta == nil {
+		db.Meta = &VaultMeta{}
+	}
+	db.Meta.LastModified = time.Now()
+}
+
+// ReadMeta loads the database at path and returns its header metadata
+// without decrypting EncryptedData, so diagnostics and migration tooling
+// don't need the master password. There's no separate Store type in this
+// package (LoadDatabase/SaveDatabase are the file-level API), so this is a
+// standalone function in the same style. Returns a zero VaultMeta for a
+// vault saved before this field existed.
+func ReadMeta(path string) (VaultMeta, error) {
+	db, err := LoadDatabase(path)
+	if err != nil {
+		return VaultMeta{}, err
+	}
+	if db.Meta == nil {
+		return VaultMeta{}, nil
+	}
+	return *db.Meta, nil
 }
 
 // CurrentKDFConfig returns the current KDF configuration.
@@ -145,6 +291,15 @@ func (db *Database) GetEncryptedMEKPhrase() ([]byte, error) {
 	return hex.DecodeString(db.EncryptedMEKPhrase)
 }
 
+// GetEncryptedKeyringSecret returns the decoded backed-up keyring secret,
+// or nil if the vault wasn't created with the keyring-backup option.
+func (db *Database) GetEncryptedKeyringSecret() ([]byte, error) {
+	if db.EncryptedKeyringSecret == "" {
+		return nil, nil
+	}
+	return hex.DecodeString(db.EncryptedKeyringSecret)
+}
+
 // GetEncryptedData returns the decoded encrypted vault data.
 func (db *Database) GetEncryptedData() ([]byte, error) {
 	return hex.DecodeString(db.EncryptedData)
@@ -155,6 +310,35 @@ func (db *Database) SetEncryptedData(data []byte) {
 	db.EncryptedData = hex.EncodeToString(data)
 }
 
+// GetSaltDuress returns the decoded salt for the duress-password slot.
+func (db *Database) GetSaltDuress() ([]byte, error) {
+	return hex.DecodeString(db.SaltDuress)
+}
+
+// GetEncryptedMEKDuress returns the decoded encrypted MEK for the duress-password slot.
+func (db *Database) GetEncryptedMEKDuress() ([]byte, error) {
+	return hex.DecodeString(db.EncryptedMEKDuress)
+}
+
+// GetEncryptedDataDuress returns the decoded encrypted decoy vault data.
+func (db *Database) GetEncryptedDataDuress() ([]byte, error) {
+	return hex.DecodeString(db.EncryptedDataDuress)
+}
+
+// SetEncryptedDataDuress sets the encrypted decoy vault data.
+func (db *Database) SetEncryptedDataDuress(data []byte) {
+	db.EncryptedDataDuress = hex.EncodeToString(data)
+}
+
+// SetDuressSlot overwrites the duress password's salt and wrapped MEK,
+// replacing whatever was there before (real or filler). Used by
+// Vault.SetDuressPassword when a user configures a duress password on an
+// already-created vault.
+func (db *Database) SetDuressSlot(saltDuress, encMEKDuress []byte) {
+	db.SaltDuress = hex.EncodeToString(saltDuress)
+	db.EncryptedMEKDuress = hex.EncodeToString(encMEKDuress)
+}
+
 // SetLastBackup updates the last backup timestamp.
 func (db *Database) SetLastBackup(t time.Time) {
 	db.LastBackup = &t
@@ -231,9 +415,59 @@ func LoadDatabase(path string) (*Database, error) {
 		return nil, ErrInvalidVersion
 	}
 
+	if err := db.validateSaltSizes(); err != nil {
+		return nil, err
+	}
+
 	return &db, nil
 }
 
+// validateSaltSizes checks that the stored salts decode to the expected
+// length, rejecting databases produced by a future/incompatible version
+// before they can be used to derive garbage keys.
+func (db *Database) validateSaltSizes() error {
+	if db.IsPhraseOnly() {
+		// Backup files intentionally clear the password salt.
+	} else if salt, err := db.GetSaltPassword(); err != nil || len(salt) != crypto.SaltSize {
+		return ErrIncompatibleVault
+	}
+
+	if salt, err := db.GetSaltPhrase(); err != nil || len(salt) != crypto.SaltSize {
+		return ErrIncompatibleVault
+	}
+
+	return nil
+}
+
+// backupSuffix is appended to a vault path to name its rolling pre-save
+// backup, e.g. "vault.c0" -> "vault.c0.bak".
+const backupSuffix = ".bak"
+
+// backupExistingFile copies the file at path to path+backupSuffix before it
+// gets overwritten, so a botched save is recoverable. Only one rolling
+// backup is kept; it's replaced atomically via rename. A missing source
+// file (first-ever save) is not an error.
+func backupExistingFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read existing vault for backup: %w", err)
+	}
+
+	tmp := path + backupSuffix + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+
+	if err := os.Rename(tmp, path+backupSuffix); err != nil {
+		return fmt.Errorf("failed to finalize backup: %w", err)
+	}
+
+	return nil
+}
+
 // SaveDatabase saves the database to a file with secure permissions.
 func SaveDatabase(db *Database, path string) error {
 	dir := filepath.Dir(path)
@@ -253,6 +487,15 @@ func SaveDatabase(db *Database, path string) error {
 	return nil
 }
 
+// SaveDatabaseWithBackup behaves like SaveDatabase but first copies any
+// existing file at path to a rolling ".bak" backup.
+func SaveDatabaseWithBackup(db *Database, path string) error {
+	if err := backupExistingFile(path); err != nil {
+		return err
+	}
+	return SaveDatabase(db, path)
+}
+
 // DatabaseExists checks if a database file exists at the given path.
 func DatabaseExists(path string) bool {
 	_, err := os.Stat(path)
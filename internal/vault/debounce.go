@@ -0,0 +1,95 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import "time"
+
+// scheduledTimer is the subset of *time.Timer that debounceClock
+// implementations need to expose, so a fake clock can be substituted in
+// tests. Mirrors the same pattern used by utils.ClipboardManager's clock.
+type scheduledTimer interface {
+	Stop() bool
+}
+
+// debounceClock abstracts time.AfterFunc so MarkDirty's debounce timer can
+// accept an injected clock in tests instead of waiting on real time.
+type debounceClock interface {
+	AfterFunc(d time.Duration, f func()) scheduledTimer
+}
+
+// realDebounceClock schedules callbacks using the real wall clock.
+type realDebounceClock struct{}
+
+func (realDebounceClock) AfterFunc(d time.Duration, f func()) scheduledTimer {
+	return time.AfterFunc(d, f)
+}
+
+// DefaultSaveDebounce is how long MarkDirty waits after the last change
+// before writing the vault to disk, coalescing rapid successive edits (e.g.
+// typing into a field) into a single save.
+const DefaultSaveDebounce = 2 * time.Second
+
+// SetSaveDebounce overrides how long MarkDirty waits before saving. A
+// non-positive value disables debouncing: MarkDirty saves immediately.
+func (v *Vault) SetSaveDebounce(d time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.saveDebounce = d
+}
+
+// SetDebounceClock overrides the clock used to schedule the debounced save.
+// Used in tests to advance time deterministically instead of waiting on
+// real time.
+func (v *Vault) SetDebounceClock(c debounceClock) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.debounceClock = c
+}
+
+// MarkDirty records that the vault has unsaved changes and (re)schedules a
+// save DefaultSaveDebounce (or whatever SetSaveDebounce set) after the last
+// call, so a burst of edits results in one save instead of one per edit.
+// Callers that mutate entries directly (rather than through methods that
+// already call Save) should call this instead of Save.
+func (v *Vault) MarkDirty() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.modified = true
+
+	if v.saveTimer != nil {
+		v.saveTimer.Stop()
+	}
+
+	if v.saveDebounce <= 0 {
+		_ = v.saveLocked()
+		return
+	}
+
+	v.saveTimer = v.debounceClock.AfterFunc(v.saveDebounce, func() {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		if v.modified {
+			_ = v.saveLocked()
+		}
+		v.saveTimer = nil
+	})
+}
+
+// Flush cancels any pending debounced save and, if there are unsaved
+// changes, saves immediately. Callers should flush on lock/exit so a
+// change made just before quitting isn't lost waiting on the debounce
+// window.
+func (v *Vault) Flush() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.saveTimer != nil {
+		v.saveTimer.Stop()
+		v.saveTimer = nil
+	}
+
+	if !v.modified {
+		return nil
+	}
+	return v.saveLocked()
+}
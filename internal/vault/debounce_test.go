@@ -0,0 +1,116 @@
+package vault
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeDebounceTimer lets tests fire a scheduled callback on demand instead
+// of waiting on real time.
+type fakeDebounceTimer struct {
+	fired bool
+}
+
+func (t *fakeDebounceTimer) Stop() bool {
+	if t.fired {
+		return false
+	}
+	t.fired = true
+	return true
+}
+
+// fakeDebounceClock records AfterFunc calls so a test can invoke the
+// callback itself, simulating the debounce window elapsing.
+type fakeDebounceClock struct {
+	calls int
+	fn    func()
+}
+
+func (c *fakeDebounceClock) AfterFunc(d time.Duration, f func()) scheduledTimer {
+	c.calls++
+	c.fn = f
+	return &fakeDebounceTimer{}
+}
+
+func (c *fakeDebounceClock) fire() {
+	if c.fn != nil {
+		c.fn()
+	}
+}
+
+func TestMarkDirtyDebouncesRapidCalls(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	fc := &fakeDebounceClock{}
+	v.SetDebounceClock(fc)
+
+	v.AddEntry(NewEntry("Entry 1"))
+	v.MarkDirty()
+	v.AddEntry(NewEntry("Entry 2"))
+	v.MarkDirty()
+	v.AddEntry(NewEntry("Entry 3"))
+	v.MarkDirty()
+
+	if fc.calls != 3 {
+		t.Errorf("Expected AfterFunc to be (re)scheduled on each MarkDirty call, got %d schedules", fc.calls)
+	}
+	if !v.IsModified() {
+		t.Errorf("Expected vault to be marked modified before the debounce fires")
+	}
+
+	fc.fire()
+
+	if v.IsModified() {
+		t.Errorf("Expected a single save once the debounce window elapses")
+	}
+
+	v.Lock()
+	reopened, err := UnlockWithPassword(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword failed: %v", err)
+	}
+	if reopened.EntryCount() != 3 {
+		t.Errorf("Expected 3 entries persisted by the debounced save, got %d", reopened.EntryCount())
+	}
+}
+
+func TestFlushSavesImmediatelyAndCancelsPendingTimer(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	fc := &fakeDebounceClock{}
+	v.SetDebounceClock(fc)
+
+	v.AddEntry(NewEntry("Entry 1"))
+	v.MarkDirty()
+
+	if err := v.Flush(); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	if v.IsModified() {
+		t.Errorf("Expected Flush to save immediately")
+	}
+
+	v.Lock()
+	reopened, err := UnlockWithPassword(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword failed: %v", err)
+	}
+	if reopened.EntryCount() != 1 {
+		t.Errorf("Expected 1 entry persisted by Flush, got %d", reopened.EntryCount())
+	}
+}
@@ -0,0 +1,122 @@
+package vault
+
+import "sort"
+
+// Diff returns the names of fields that differ between e and other, for
+// surfacing "what changed" without diffing the raw struct (e.g. skipping
+// bookkeeping fields like Updated and UseCount that change on every touch).
+// Assumes e and other are revisions of the same logical entry; ID is not
+// itself compared.
+func (e *Entry) Diff(other *Entry) []string {
+	var changed []string
+	if e.Title != other.Title {
+		changed = append(changed, "Title")
+	}
+	if e.Username != other.Username {
+		changed = append(changed, "Username")
+	}
+	if e.Password != other.Password {
+		changed = append(changed, "Password")
+	}
+	if e.URL != other.URL {
+		changed = append(changed, "URL")
+	}
+	if e.Notes != other.Notes {
+		changed = append(changed, "Notes")
+	}
+	if e.TOTPSecret != other.TOTPSecret {
+		changed = append(changed, "TOTPSecret")
+	}
+	if e.Color != other.Color {
+		changed = append(changed, "Color")
+	}
+	if e.IsArchived != other.IsArchived {
+		changed = append(changed, "IsArchived")
+	}
+	if !stringSlicesEqual(e.Tags, other.Tags) {
+		changed = append(changed, "Tags")
+	}
+	return changed
+}
+
+// stringSlicesEqual compares two string slices for equality, ignoring
+// neither order nor length shortcuts since tag order is meaningful to the
+// user (e.g. an intentional reorder still counts as a change).
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SnapshotDiff is the result of comparing two EntryList snapshots, e.g. the
+// vault before and after a pending change, for a review screen shown before
+// saving.
+type SnapshotDiff struct {
+	// Added lists the IDs of entries present in the new snapshot but not
+	// the old one.
+	Added []string
+	// Removed lists the IDs of entries present in the old snapshot but not
+	// the new one.
+	Removed []string
+	// Modified lists the IDs of entries present in both snapshots whose
+	// fields differ (see Entry.Diff).
+	Modified []string
+	// FieldChanges maps the ID of each entry in Modified to the field
+	// names that changed, as returned by Entry.Diff.
+	FieldChanges map[string][]string
+}
+
+// IsEmpty reports whether the diff contains no additions, removals, or
+// modifications, i.e. the two snapshots are equivalent.
+func (d SnapshotDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+// DiffSnapshots compares two EntryList snapshots by entry ID and reports
+// what was added, removed, and modified, for a review screen shown before
+// committing a pending change (e.g. an import or restore) to the vault.
+func DiffSnapshots(old, new EntryList) SnapshotDiff {
+	oldByID := make(map[string]*Entry, len(old))
+	for _, e := range old {
+		if e != nil {
+			oldByID[e.ID] = e
+		}
+	}
+	newByID := make(map[string]*Entry, len(new))
+	for _, e := range new {
+		if e != nil {
+			newByID[e.ID] = e
+		}
+	}
+
+	diff := SnapshotDiff{FieldChanges: make(map[string][]string)}
+
+	for id, newEntry := range newByID {
+		oldEntry, existed := oldByID[id]
+		if !existed {
+			diff.Added = append(diff.Added, id)
+			continue
+		}
+		if changed := oldEntry.Diff(newEntry); len(changed) > 0 {
+			diff.Modified = append(diff.Modified, id)
+			diff.FieldChanges[id] = changed
+		}
+	}
+	for id := range oldByID {
+		if _, stillExists := newByID[id]; !stillExists {
+			diff.Removed = append(diff.Removed, id)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Modified)
+
+	return diff
+}
@@ -0,0 +1,67 @@
+package vault
+
+import "testing"
+
+func TestDiffSnapshotsDetectsAdditions(t *testing.T) {
+	a := NewEntry("A")
+	old := EntryList{a}
+	newList := EntryList{a, NewEntry("B")}
+
+	diff := DiffSnapshots(old, newList)
+
+	if len(diff.Added) != 1 || diff.Added[0] != newList[1].ID {
+		t.Fatalf("Expected one addition, got %v", diff.Added)
+	}
+	if len(diff.Removed) != 0 || len(diff.Modified) != 0 {
+		t.Fatalf("Expected no removals or modifications, got %+v", diff)
+	}
+}
+
+func TestDiffSnapshotsDetectsRemovals(t *testing.T) {
+	a := NewEntry("A")
+	b := NewEntry("B")
+	old := EntryList{a, b}
+	newList := EntryList{a}
+
+	diff := DiffSnapshots(old, newList)
+
+	if len(diff.Removed) != 1 || diff.Removed[0] != b.ID {
+		t.Fatalf("Expected one removal, got %v", diff.Removed)
+	}
+	if len(diff.Added) != 0 || len(diff.Modified) != 0 {
+		t.Fatalf("Expected no additions or modifications, got %+v", diff)
+	}
+}
+
+func TestDiffSnapshotsDetectsFieldChange(t *testing.T) {
+	a := NewEntry("A")
+	a.Username = "old-user"
+	old := EntryList{a}
+
+	changed := NewEntry("A")
+	changed.ID = a.ID
+	changed.Username = "new-user"
+	newList := EntryList{changed}
+
+	diff := DiffSnapshots(old, newList)
+
+	if len(diff.Modified) != 1 || diff.Modified[0] != a.ID {
+		t.Fatalf("Expected one modification, got %v", diff.Modified)
+	}
+	fields := diff.FieldChanges[a.ID]
+	if len(fields) != 1 || fields[0] != "Username" {
+		t.Fatalf("Expected Username to be reported changed, got %v", fields)
+	}
+}
+
+func TestDiffSnapshotsIdenticalIsEmpty(t *testing.T) {
+	a := NewEntry("A")
+	b := NewEntry("B")
+	list := EntryList{a, b}
+
+	diff := DiffSnapshots(list, list)
+
+	if !diff.IsEmpty() {
+		t.Fatalf("Expected an empty diff for identical snapshots, got %+v", diff)
+	}
+}
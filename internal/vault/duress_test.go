@@ -0,0 +1,154 @@
+package vault
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCreateWithDuressOpensDecoyUnderDuressPassword(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := CreateWithDuress(vaultPath, "real-password-123", "duress-password-456")
+	if err != nil {
+		t.Fatalf("CreateWithDuress failed: %v", err)
+	}
+	if err := v.AddEntry(NewEntry("Real Entry")); err != nil {
+		t.Fatalf("AddEntry failed: %v", err)
+	}
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	v.Lock()
+
+	real, err := UnlockWithPassword(vaultPath, "real-password-123")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword(real) failed: %v", err)
+	}
+	defer real.Lock()
+	if len(real.Entries()) != 1 {
+		t.Errorf("Expected the real vault to still have its entry, got %d", len(real.Entries()))
+	}
+
+	decoy, err := UnlockWithPassword(vaultPath, "duress-password-456")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword(duress) failed: %v", err)
+	}
+	defer decoy.Lock()
+	if len(decoy.Entries()) != 0 {
+		t.Errorf("Expected the decoy vault to start empty, got %d entries", len(decoy.Entries()))
+	}
+}
+
+func TestSetDuressPasswordOnExistingVault(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := Create(vaultPath, "real-password-123")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if err := v.SetDuressPassword("wrong-password", "duress-password-456"); err != ErrWrongPassword {
+		t.Fatalf("Expected ErrWrongPassword for wrong current password, got %v", err)
+	}
+
+	if err := v.SetDuressPassword("real-password-123", "duress-password-456"); err != nil {
+		t.Fatalf("SetDuressPassword failed: %v", err)
+	}
+	v.Lock()
+
+	decoy, err := UnlockWithPassword(vaultPath, "duress-password-456")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword(duress) failed: %v", err)
+	}
+	defer decoy.Lock()
+	if len(decoy.Entries()) != 0 {
+		t.Errorf("Expected a freshly configured decoy vault to start empty, got %d entries", len(decoy.Entries()))
+	}
+
+	real, err := UnlockWithPassword(vaultPath, "real-password-123")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword(real) failed after SetDuressPassword: %v", err)
+	}
+	real.Lock()
+}
+
+func TestDuressVaultSavesIndependentlyOfRealVault(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := CreateWithDuress(vaultPath, "real-password-123", "duress-password-456")
+	if err != nil {
+		t.Fatalf("CreateWithDuress failed: %v", err)
+	}
+	v.Lock()
+
+	decoy, err := UnlockWithPassword(vaultPath, "duress-password-456")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword(duress) failed: %v", err)
+	}
+	if err := decoy.AddEntry(NewEntry("Decoy Entry")); err != nil {
+		t.Fatalf("AddEntry on decoy failed: %v", err)
+	}
+	if err := decoy.Save(); err != nil {
+		t.Fatalf("Save on decoy failed: %v", err)
+	}
+	decoy.Lock()
+
+	real, err := UnlockWithPassword(vaultPath, "real-password-123")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword(real) failed: %v", err)
+	}
+	defer real.Lock()
+	if len(real.Entries()) != 0 {
+		t.Errorf("Expected entries added to the decoy vault not to leak into the real vault, got %d", len(real.Entries()))
+	}
+
+	decoy2, err := UnlockWithPassword(vaultPath, "duress-password-456")
+	if err != nil {
+		t.Fatalf("UnlockWithPassword(duress) failed after save: %v", err)
+	}
+	defer decoy2.Lock()
+	if len(decoy2.Entries()) != 1 {
+		t.Errorf("Expected the decoy vault's saved entry to persist, got %d", len(decoy2.Entries()))
+	}
+}
+
+func TestPlainVaultHasConstantSizedDuressSlot(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	plainPath := filepath.Join(tmpDir, "plain.vault")
+	duressPath := filepath.Join(tmpDir, "duress.vault")
+
+	plain, _, err := Create(plainPath, "just-a-password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer plain.Lock()
+
+	duress, _, err := CreateWithDuress(duressPath, "just-a-password", "a-duress-password")
+	if err != nil {
+		t.Fatalf("CreateWithDuress failed: %v", err)
+	}
+	defer duress.Lock()
+
+	plainSalt, _ := plain.db.GetSaltDuress()
+	duressSalt, _ := duress.db.GetSaltDuress()
+	if len(plainSalt) != len(duressSalt) {
+		t.Errorf("Expected SaltDuress to be the same length whether or not a duress password is configured, got %d vs %d", len(plainSalt), len(duressSalt))
+	}
+
+	plainMEK, _ := plain.db.GetEncryptedMEKDuress()
+	duressMEK, _ := duress.db.GetEncryptedMEKDuress()
+	if len(plainMEK) != len(duressMEK) {
+		t.Errorf("Expected EncryptedMEKDuress to be the same length whether or not a duress password is configured, got %d vs %d", len(plainMEK), len(duressMEK))
+	}
+
+	if _, err := UnlockWithPassword(plainPath, "a-duress-password"); err == nil {
+		t.Fatal("Expected a plain vault's random duress filler to reject every password")
+	}
+}
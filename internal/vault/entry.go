@@ -2,9 +2,11 @@
 package vault
 
 import (
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/batterdaysahead/cipher0/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -17,17 +19,80 @@ type Entry struct {
 	// Username is the username/email for the entry.
 	Username string `json:"username,omitempty"`
 	// Password is the password for the entry.
+	//
+	// Deprecated: assign via ChangePassword instead of writing this field
+	// directly, so the update timestamp (and, once a history store exists,
+	// the prior value) stay in sync with the new password.
 	Password string `json:"password,omitempty"`
 	// URL is the website URL for the entry.
 	URL string `json:"url,omitempty"`
-	// Notes contains additional notes (multiline).
+	// Notes contains additional notes (multiline). Empty once
+	// NotesExternalized is true; use OpenNotes/SealNotes instead of reading
+	// or writing this field directly for an entry with large notes.
 	Notes string `json:"notes,omitempty"`
+	// NotesExternalized marks that Notes has been sealed into NotesSealed
+	// under a notes-specific subkey via SealNotes, so editing a short field
+	// elsewhere on the entry doesn't require re-encrypting a large note.
+	NotesExternalized bool `json:"notes_externalized,omitempty"`
+	// NotesSealed holds the base64-encoded, independently encrypted notes
+	// when NotesExternalized is true. See SealNotes/OpenNotes.
+	NotesSealed string `json:"notes_sealed,omitempty"`
 	// TOTPSecret is the TOTP secret for 2FA (optional).
 	TOTPSecret string `json:"totp_secret,omitempty"`
+	// TOTPAlgorithm, TOTPDigits, and TOTPPeriod hold non-default TOTP
+	// parameters parsed from an otpauth:// URL (see SetTOTP). Empty/zero
+	// means "use the package defaults" (SHA1/6 digits/30s), same as a zero
+	// totp.TOTPConfig.
+	TOTPAlgorithm string `json:"totp_algorithm,omitempty"`
+	TOTPDigits    int    `json:"totp_digits,omitempty"`
+	TOTPPeriod    int    `json:"totp_period,omitempty"`
+	// Tags holds free-form labels for grouping and filtering entries.
+	Tags []string `json:"tags,omitempty"`
+	// Sensitive marks an entry (e.g. root credentials) as requiring the
+	// master password to be re-entered before it can be revealed.
+	Sensitive bool `json:"sensitive,omitempty"`
+	// IsArchived hides the entry from the default list view without
+	// deleting it, separately from the trash/delete flow. Archived entries
+	// still count toward security reports like reused-password detection.
+	IsArchived bool `json:"archived,omitempty"`
 	// Created is the timestamp when the entry was created.
 	Created time.Time `json:"created"`
 	// Updated is the timestamp when the entry was last updated.
 	Updated time.Time `json:"updated"`
+
+	// Strength is the entry's password strength, computed on demand by
+	// EntryList.AnnotateStrength (e.g. right after an import) rather than
+	// kept up to date automatically. It's transient and not persisted.
+	Strength utils.PasswordStrength `json:"-"`
+
+	// CustomFields holds additional name/value pairs beyond the built-in
+	// fields, e.g. a card number or SSH passphrase. See EntryTemplate.
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+
+	// Breach is the most recent breach check result for Password, set via
+	// SetBreachInfo. Nil means never checked. See BreachInfo.
+	Breach *BreachInfo `json:"breach,omitempty"`
+
+	// UseCount counts how many times Touch has been called on this entry,
+	// e.g. each time its password is copied, powering a "most used" view
+	// (EntryList.TopUsed). Purely local bookkeeping; never transmitted.
+	UseCount int `json:"use_count,omitempty"`
+
+	// Color is a palette key (e.g. "blue", "green") for visual grouping,
+	// e.g. work vs. personal accounts. Empty means no color tag. See
+	// ui.RenderColorDot and EntryList.FilterByColor.
+	Color string `json:"color,omitempty"`
+}
+
+// CustomField is a user-defined name/value pair for account types (e.g.
+// credit cards, SSH keys) that don't fit the built-in fields.
+type CustomField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// Sensitive mirrors Entry.Sensitive but at the field level, for an
+	// individual field (e.g. a CVV) that should stay masked even when the
+	// rest of the entry is revealed.
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // NewEntry creates a new entry with a generated UUID and current timestamp.
@@ -46,11 +111,50 @@ func (e *Entry) Update() {
 	e.Updated = time.Now()
 }
 
+// ChangePassword is the single supported way to set a new password: it
+// records the old value into history (once a history store exists), sets
+// the new value, and bumps Updated, all in one call. Changing to the
+// current value is a no-op.
+func (e *Entry) ChangePassword(newPassword string) {
+	if newPassword == e.Password {
+		return
+	}
+
+	e.Password = newPassword
+	e.Update()
+}
+
+// Touch increments UseCount and bumps Updated, for callers to record that an
+// entry was actually used (e.g. its password was copied), separate from
+// Update's use for field edits.
+func (e *Entry) Touch() {
+	e.UseCount++
+	e.Update()
+}
+
 // HasTOTP returns true if the entry has a TOTP secret configured.
 func (e *Entry) HasTOTP() bool {
 	return e.TOTPSecret != ""
 }
 
+// Archive marks the entry as archived and bumps Updated.
+func (e *Entry) Archive() {
+	if e.IsArchived {
+		return
+	}
+	e.IsArchived = true
+	e.Update()
+}
+
+// Unarchive clears the archived flag and bumps Updated.
+func (e *Entry) Unarchive() {
+	if !e.IsArchived {
+		return
+	}
+	e.IsArchived = false
+	e.Update()
+}
+
 // EntryList is a slice of entries with helper methods.
 type EntryList []*Entry
 
@@ -92,6 +196,107 @@ func (el EntryList) Search(query string) EntryList {
 	return results
 }
 
+// FirstIndexWithPrefix returns the index of the first entry (starting just
+// after from and wrapping around the whole list) whose title starts with
+// prefix, case-insensitively. Returns -1 if no entry matches.
+func (el EntryList) FirstIndexWithPrefix(prefix string, from int) int {
+	if len(el) == 0 || prefix == "" {
+		return -1
+	}
+
+	prefix = strings.ToLower(prefix)
+	for i := 1; i <= len(el); i++ {
+		idx := (from + i) % len(el)
+		if strings.HasPrefix(strings.ToLower(el[idx].Title), prefix) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// Archive marks the entry with the given ID as archived. Returns false if no
+// such entry exists.
+func (el EntryList) Archive(id string) bool {
+	e := el.FindByID(id)
+	if e == nil {
+		return false
+	}
+	e.Archive()
+	return true
+}
+
+// Unarchive clears the archived flag on the entry with the given ID. Returns
+// false if no such entry exists.
+func (el EntryList) Unarchive(id string) bool {
+	e := el.FindByID(id)
+	if e == nil {
+		return false
+	}
+	e.Unarchive()
+	return true
+}
+
+// Archived returns only the archived entries.
+func (el EntryList) Archived() EntryList {
+	var results EntryList
+	for _, e := range el {
+		if e.IsArchived {
+			results = append(results, e)
+		}
+	}
+	return results
+}
+
+// AnnotateStrength computes and stores a Strength score for every entry in
+// el, so a weak-password report (e.g. right after an import) doesn't need
+// to recompute it on every render.
+func (el EntryList) AnnotateStrength() {
+	for _, e := range el {
+		e.Strength = utils.CalculateStrength(e.Password)
+	}
+}
+
+// TopUsed returns up to n entries sorted by UseCount descending (ties broken
+// by original order), for a "most used" view. Returns all entries if n
+// exceeds len(el).
+func (el EntryList) TopUsed(n int) EntryList {
+	sorted := make(EntryList, len(el))
+	copy(sorted, el)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].UseCount > sorted[j].UseCount
+	})
+
+	if n < 0 {
+		n = 0
+	}
+	if n > len(sorted) {
+		n = len(sorted)
+	}
+	return sorted[:n]
+}
+
+// FilterByColor returns only the entries tagged with the given color key.
+func (el EntryList) FilterByColor(color string) EntryList {
+	var results EntryList
+	for _, e := range el {
+		if e.Color == color {
+			results = append(results, e)
+		}
+	}
+	return results
+}
+
+// Active returns only the non-archived entries, i.e. the default list view.
+func (el EntryList) Active() EntryList {
+	var results EntryList
+	for _, e := range el {
+		if !e.IsArchived {
+			results = append(results, e)
+		}
+	}
+	return results
+}
+
 // containsIgnoreCase checks if s contains substr (case-insensitive).
 // Uses strings.ToLower for proper Unicode support.
 func containsIgnoreCase(s, substr string) bool {
@@ -0,0 +1,18 @@
+package vault
+
+import "errors"
+
+// ErrDuplicateID is returned by EntryList.Add when e's ID already exists in
+// the list, e.g. when an importer sets IDs from an external source that can
+// collide rather than generating fresh ones via NewEntry.
+var ErrDuplicateID = errors.New("entry with this ID already exists")
+
+// Add appends e to *el, rejecting it with ErrDuplicateID if an entry with
+// the same ID is already present.
+func (el *EntryList) Add(e *Entry) error {
+	if el.FindByID(e.ID) != nil {
+		return ErrDuplicateID
+	}
+	*el = append(*el, e)
+	return nil
+}
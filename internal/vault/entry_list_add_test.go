@@ -0,0 +1,33 @@
+package vault
+
+import "testing"
+
+func TestEntryListAddRejectsDuplicateID(t *testing.T) {
+	var list EntryList
+	e1 := NewEntry("GitHub")
+	if err := list.Add(e1); err != nil {
+		t.Fatalf("Add failed for fresh ID: %v", err)
+	}
+
+	e2 := NewEntry("GitLab")
+	e2.ID = e1.ID
+	if err := list.Add(e2); err != ErrDuplicateID {
+		t.Fatalf("Expected ErrDuplicateID, got %v", err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("Expected list to still have 1 entry, got %d", len(list))
+	}
+}
+
+func TestEntryListAddAcceptsFreshID(t *testing.T) {
+	var list EntryList
+	if err := list.Add(NewEntry("GitHub")); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := list.Add(NewEntry("GitLab")); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(list))
+	}
+}
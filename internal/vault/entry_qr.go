@@ -0,0 +1,148 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+	"github.com/skip2/go-qrcode"
+)
+
+// maxEntryQRPayloadBytes bounds the size of an EntryToQRPayload result.
+// QR codes can technically hold a few kilobytes, but reliably scanning one
+// from a phone camera (the whole point of this feature) needs a low enough
+// version/density to stay readable, so entries beyond this are rejected in
+// favor of the full encrypted backup flow (ExportBackupWithPassword).
+const maxEntryQRPayloadBytes = 900
+
+// ErrEntryTooLargeForQR is returned by EntryToQRPayload when the entry (plus
+// a large CustomFields set or Notes) wouldn't fit in a reliably scannable QR
+// code. Use ExportBackupWithPassword instead for entries this large.
+var ErrEntryTooLargeForQR = errors.New("entry is too large to fit in a QR code; use ExportBackupWithPassword instead")
+
+// entryQRFields is the portable subset of Entry fields carried by an
+// EntryToQRPayload export. It deliberately omits ID, timestamps, Strength,
+// and Breach, which are either local bookkeeping or recomputed on import.
+type entryQRFields struct {
+	Title        string        `json:"title"`
+	Username     string        `json:"username,omitempty"`
+	Password     string        `json:"password,omitempty"`
+	URL          string        `json:"url,omitempty"`
+	Notes        string        `json:"notes,omitempty"`
+	TOTPSecret   string        `json:"totp_secret,omitempty"`
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+}
+
+// entryQREnvelope is the JSON structure actually encoded into the QR
+// payload: a salt for password-based key derivation plus the encrypted
+// entryQRFields, mirroring the salt+ciphertext shape ExportBackupWithPassword
+// uses for standalone backups, since this export also never touches the
+// live vault's MEK or the OS keyring.
+type entryQREnvelope struct {
+	Salt       string `json:"salt"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// EntryToQRPayload encrypts e under a key derived from password and returns
+// a compact JSON payload suitable for QRCodeForEntryPayload, for sharing a
+// single login with a trusted device (e.g. scanning it on a phone) without
+// exporting the whole vault. Returns ErrEntryTooLargeForQR if the result
+// wouldn't fit in a reliably scannable QR code; use ExportBackupWithPassword
+// for those entries instead.
+func EntryToQRPayload(e *Entry, password string) ([]byte, error) {
+	fields := entryQRFields{
+		Title:        e.Title,
+		Username:     e.Username,
+		Password:     e.Password,
+		URL:          e.URL,
+		Notes:        e.Notes,
+		TOTPSecret:   e.TOTPSecret,
+		CustomFields: e.CustomFields,
+	}
+
+	plaintext, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal entry: %w", err)
+	}
+
+	salt, err := crypto.GenerateSalt()
+	if err != nil {
+		return nil, err
+	}
+	key := crypto.DeriveKey([]byte(password), salt)
+	defer crypto.ZeroMemory(key)
+
+	ciphertext, err := crypto.Encrypt(plaintext, key)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(entryQREnvelope{
+		Salt:       base64.StdEncoding.EncodeToString(salt),
+		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	if len(payload) > maxEntryQRPayloadBytes {
+		return nil, ErrEntryTooLargeForQR
+	}
+
+	return payload, nil
+}
+
+// EntryFromQRPayload reverses EntryToQRPayload, decrypting payload with
+// password and returning a freshly created Entry (new ID and timestamps,
+// as if the user had just added it by hand). Returns ErrWrongPassword if
+// password doesn't match the one EntryToQRPayload was called with.
+func EntryFromQRPayload(payload []byte, password string) (*Entry, error) {
+	var envelope entryQREnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid QR payload: %w", err)
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(envelope.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid QR payload: %w", err)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(envelope.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("invalid QR payload: %w", err)
+	}
+
+	key := crypto.DeriveKey([]byte(password), salt)
+	defer crypto.ZeroMemory(key)
+
+	plaintext, err := crypto.Decrypt(ciphertext, key)
+	if err != nil {
+		return nil, ErrWrongPassword
+	}
+
+	var fields entryQRFields
+	if err := json.Unmarshal(plaintext, &fields); err != nil {
+		return nil, fmt.Errorf("invalid QR payload: %w", err)
+	}
+
+	e := NewEntry(fields.Title)
+	e.Username = fields.Username
+	e.Password = fields.Password
+	e.URL = fields.URL
+	e.Notes = fields.Notes
+	e.TOTPSecret = fields.TOTPSecret
+	e.CustomFields = fields.CustomFields
+	return e, nil
+}
+
+// RenderEntryQRCode renders payload (from EntryToQRPayload) as ASCII/Unicode
+// QR art for terminal display, the same way totp.RenderQRCodeASCII does for
+// otpauth:// URLs.
+func RenderEntryQRCode(payload []byte) (string, error) {
+	qr, err := qrcode.New(string(payload), qrcode.Low)
+	if err != nil {
+		return "", fmt.Errorf("failed to create QR code: %w", err)
+	}
+	return qr.ToSmallString(false), nil
+}
@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEntryToQRPayloadRoundTrip(t *testing.T) {
+	e := NewEntry("GitHub")
+	e.Username = "octocat"
+	e.Password = "correct-horse-battery-staple"
+	e.URL = "https://github.com"
+
+	payload, err := EntryToQRPayload(e, "sharing-password")
+	if err != nil {
+		t.Fatalf("EntryToQRPayload failed: %v", err)
+	}
+
+	got, err := EntryFromQRPayload(payload, "sharing-password")
+	if err != nil {
+		t.Fatalf("EntryFromQRPayload failed: %v", err)
+	}
+	if got.Title != e.Title || got.Username != e.Username || got.Password != e.Password || got.URL != e.URL {
+		t.Fatalf("Round-tripped entry doesn't match original: %+v", got)
+	}
+
+	if _, err := RenderEntryQRCode(payload); err != nil {
+		t.Fatalf("RenderEntryQRCode failed: %v", err)
+	}
+}
+
+func TestEntryFromQRPayloadRejectsWrongPassword(t *testing.T) {
+	e := NewEntry("GitHub")
+	e.Password = "correct-horse-battery-staple"
+
+	payload, err := EntryToQRPayload(e, "sharing-password")
+	if err != nil {
+		t.Fatalf("EntryToQRPayload failed: %v", err)
+	}
+
+	if _, err := EntryFromQRPayload(payload, "wrong-password"); err != ErrWrongPassword {
+		t.Fatalf("Expected ErrWrongPassword, got %v", err)
+	}
+}
+
+func TestEntryToQRPayloadRejectsOversizedEntry(t *testing.T) {
+	e := NewEntry("GitHub")
+	e.Notes = strings.Repeat("x", 2000)
+
+	if _, err := EntryToQRPayload(e, "sharing-password"); err != ErrEntryTooLargeForQR {
+		t.Fatalf("Expected ErrEntryTooLargeForQR, got %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+// EntryTemplate identifies a predefined set of custom fields for a common
+// account type, so creating an entry for e.g. a credit card doesn't require
+// the user to add each field by hand.
+type EntryTemplate int
+
+const (
+	// TemplateLogin is a plain username/password entry: no custom fields.
+	TemplateLogin EntryTemplate = iota
+	TemplateCreditCard
+	TemplateSSHKey
+	TemplateSecureNote
+)
+
+// fieldNames returns the custom field names t pre-populates, in display
+// order.
+func (t EntryTemplate) fieldNames() []string {
+	switch t {
+	case TemplateCreditCard:
+		return []string{"Cardholder Name", "Card Number", "Expiration", "CVV"}
+	case TemplateSSHKey:
+		return []string{"Private Key", "Public Key", "Passphrase"}
+	case TemplateSecureNote, TemplateLogin:
+		return nil
+	default:
+		return nil
+	}
+}
+
+// NewEntryFromTemplate creates a new entry named name, pre-populated with
+// empty custom fields for t's account type. TemplateLogin adds none,
+// matching the shape of a plain NewEntry.
+func NewEntryFromTemplate(name string, t EntryTemplate) *Entry {
+	e := NewEntry(name)
+	for _, fieldName := range t.fieldNames() {
+		e.CustomFields = append(e.CustomFields, CustomField{Name: fieldName})
+	}
+	return e
+}
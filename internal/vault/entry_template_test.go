@@ -0,0 +1,45 @@
+package vault
+
+import "testing"
+
+func fieldNamesOf(e *Entry) []string {
+	names := make([]string, len(e.CustomFields))
+	for i, f := range e.CustomFields {
+		names[i] = f.Name
+	}
+	return names
+}
+
+func TestNewEntryFromTemplateFieldNames(t *testing.T) {
+	tests := []struct {
+		template EntryTemplate
+		want     []string
+	}{
+		{TemplateCreditCard, []string{"Cardholder Name", "Card Number", "Expiration", "CVV"}},
+		{TemplateSSHKey, []string{"Private Key", "Public Key", "Passphrase"}},
+		{TemplateSecureNote, nil},
+	}
+
+	for _, tt := range tests {
+		e := NewEntryFromTemplate("Test", tt.template)
+		got := fieldNamesOf(e)
+		if len(got) != len(tt.want) {
+			t.Fatalf("template %v: expected fields %v, got %v", tt.template, tt.want, got)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("template %v: expected field %d to be %q, got %q", tt.template, i, tt.want[i], got[i])
+			}
+		}
+	}
+}
+
+func TestNewEntryFromTemplateLoginMatchesPlainEntry(t *testing.T) {
+	e := NewEntryFromTemplate("Test", TemplateLogin)
+	if len(e.CustomFields) != 0 {
+		t.Errorf("Expected TemplateLogin to add no custom fields, got %v", e.CustomFields)
+	}
+	if e.Title != "Test" {
+		t.Errorf("Expected Title %q, got %q", "Test", e.Title)
+	}
+}
@@ -0,0 +1,86 @@
+package vault
+
+import "testing"
+
+func TestEntryChangePassword(t *testing.T) {
+	entry := NewEntry("Test Entry")
+	entry.Password = "old-password"
+	originalUpdated := entry.Updated
+
+	entry.ChangePassword("new-password")
+
+	if entry.Password != "new-password" {
+		t.Errorf("Expected password to be updated, got %q", entry.Password)
+	}
+	if !entry.Updated.After(originalUpdated) {
+		t.Error("Expected Updated to advance after ChangePassword")
+	}
+}
+
+func TestEntryChangePasswordSameValueIsNoOp(t *testing.T) {
+	entry := NewEntry("Test Entry")
+	entry.Password = "same-password"
+	entry.Update()
+	originalUpdated := entry.Updated
+
+	entry.ChangePassword("same-password")
+
+	if entry.Updated != originalUpdated {
+		t.Error("Expected Updated to stay unchanged when the password doesn't change")
+	}
+}
+
+func TestEntryTouchIncrementsUseCount(t *testing.T) {
+	entry := NewEntry("Test Entry")
+	originalUpdated := entry.Updated
+
+	entry.Touch()
+	entry.Touch()
+
+	if entry.UseCount != 2 {
+		t.Errorf("Expected UseCount 2, got %d", entry.UseCount)
+	}
+	if !entry.Updated.After(originalUpdated) {
+		t.Error("Expected Updated to advance after Touch")
+	}
+}
+
+func TestEntryListTopUsed(t *testing.T) {
+	a := NewEntry("A")
+	a.UseCount = 1
+	b := NewEntry("B")
+	b.UseCount = 5
+	c := NewEntry("C")
+	c.UseCount = 3
+
+	list := EntryList{a, b, c}
+
+	top := list.TopUsed(2)
+	if len(top) != 2 || top[0] != b || top[1] != c {
+		t.Fatalf("Expected [B, C], got %v", top)
+	}
+
+	if all := list.TopUsed(10); len(all) != 3 {
+		t.Errorf("Expected TopUsed(10) to return all 3 entries, got %d", len(all))
+	}
+}
+
+func TestEntryListFilterByColor(t *testing.T) {
+	work := NewEntry("Work")
+	work.Color = "blue"
+	personal := NewEntry("Personal")
+	personal.Color = "green"
+	other := NewEntry("Other")
+	other.Color = "blue"
+
+	list := EntryList{work, personal, other}
+
+	blue := list.FilterByColor("blue")
+	if len(blue) != 2 || blue[0] != work || blue[1] != other {
+		t.Fatalf("Expected [Work, Other], got %v", blue)
+	}
+
+	if none := list.FilterByColor("red"); len(none) != 0 {
+		t.Errorf("Expected no entries for an unused color, got %d", len(none))
+	}
+}
@@ -0,0 +1,124 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+// CurrentEntryFormatVersion is the current entry schema version. It's bumped
+// whenever a field is added to Entry that an older build wouldn't know how
+// to round-trip; ExportCompat uses it to decide what to drop when targeting
+// an older version.
+//
+// Version 1 predates IsArchived.
+const CurrentEntryFormatVersion = 2
+
+// ErrUnsupportedExportVersion is returned by ExportCompat when targetVersion
+// is newer than CurrentEntryFormatVersion or isn't a version this build
+// knows how to downgrade to.
+var ErrUnsupportedExportVersion = errors.New("unsupported export target version")
+
+// compatEntryV1 mirrors Entry as it existed before IsArchived was added.
+type compatEntryV1 struct {
+	ID         string    `json:"id"`
+	Title      string    `json:"title"`
+	Username   string    `json:"username,omitempty"`
+	Password   string    `json:"password,omitempty"`
+	URL        string    `json:"url,omitempty"`
+	Notes      string    `json:"notes,omitempty"`
+	TOTPSecret string    `json:"totp_secret,omitempty"`
+	Tags       []string  `json:"tags,omitempty"`
+	Sensitive  bool      `json:"sensitive,omitempty"`
+	Created    time.Time `json:"created"`
+	Updated    time.Time `json:"updated"`
+}
+
+// compatExport is the on-disk shape ExportCompat writes: a version tag plus
+// the encrypted entries, so a reader on the older build knows which schema
+// to decode EncryptedEntries into before decrypting.
+type compatExport struct {
+	Version          int    `json:"version"`
+	EncryptedEntries string `json:"encrypted_entries"`
+}
+
+// DroppedFieldsForVersion reports which Entry fields ExportCompat will omit
+// when targeting targetVersion, so a caller can warn the user before
+// writing the export. Returns nil if nothing would be dropped.
+func DroppedFieldsForVersion(targetVersion int) []string {
+	if targetVersion >= CurrentEntryFormatVersion {
+		return nil
+	}
+	if targetVersion == 1 {
+		return []string{"archived"}
+	}
+	return nil
+}
+
+// ExportCompat writes entries to w in an older entry schema version, e.g. so
+// a vault can be opened by a build that predates a newer field. Fields the
+// target version doesn't understand are silently dropped; call
+// DroppedFieldsForVersion first to warn the user which ones. Entries are
+// still encrypted with mek (using crypto.Encrypt, not EncryptWithAAD, since
+// there's no Database header to bind an AAD to at this layer), so the
+// export is only readable by something that also has the MEK.
+//
+// Targeting a version newer than CurrentEntryFormatVersion, or older than 1,
+// returns ErrUnsupportedExportVersion.
+func ExportCompat(w io.Writer, entries EntryList, targetVersion int, mek []byte) error {
+	if targetVersion > CurrentEntryFormatVersion || targetVersion < 1 {
+		return ErrUnsupportedExportVersion
+	}
+
+	var payload any
+	switch targetVersion {
+	case 1:
+		compat := make([]compatEntryV1, len(entries))
+		for i, e := range entries {
+			compat[i] = compatEntryV1{
+				ID:         e.ID,
+				Title:      e.Title,
+				Username:   e.Username,
+				Password:   e.Password,
+				URL:        e.URL,
+				Notes:      e.Notes,
+				TOTPSecret: e.TOTPSecret,
+				Tags:       e.Tags,
+				Sensitive:  e.Sensitive,
+				Created:    e.Created,
+				Updated:    e.Updated,
+			}
+		}
+		payload = compat
+	default:
+		payload = entries
+	}
+
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal entries: %w", err)
+	}
+	defer crypto.ZeroMemory(plaintext)
+
+	encrypted, err := crypto.Encrypt(plaintext, mek)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt entries: %w", err)
+	}
+
+	out, err := json.MarshalIndent(compatExport{
+		Version:          targetVersion,
+		EncryptedEntries: hex.EncodeToString(encrypted),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal export: %w", err)
+	}
+
+	_, err = w.Write(out)
+	return err
+}
@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+func TestExportCompatOmitsNewFieldsForOlderVersion(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	e := NewEntry("Entry 1")
+	e.Archive()
+	v.AddEntry(e)
+
+	mekBytes, cleanup, err := v.mek.Bytes()
+	if err != nil {
+		t.Fatalf("failed to read MEK: %v", err)
+	}
+	defer cleanup()
+
+	var buf bytes.Buffer
+	if err := ExportCompat(&buf, v.Entries(), 1, mekBytes); err != nil {
+		t.Fatalf("ExportCompat failed: %v", err)
+	}
+
+	var export compatExport
+	if err := json.Unmarshal(buf.Bytes(), &export); err != nil {
+		t.Fatalf("failed to parse export: %v", err)
+	}
+	if export.Version != 1 {
+		t.Errorf("Expected version 1, got %d", export.Version)
+	}
+
+	encrypted, err := hex.DecodeString(export.EncryptedEntries)
+	if err != nil {
+		t.Fatalf("failed to decode encrypted entries: %v", err)
+	}
+	plaintext, err := crypto.Decrypt(encrypted, mekBytes)
+	if err != nil {
+		t.Fatalf("failed to decrypt entries: %v", err)
+	}
+
+	if strings.Contains(string(plaintext), "archived") {
+		t.Errorf("Expected v1 export to omit the archived field, got %s", plaintext)
+	}
+
+	var decoded []compatEntryV1
+	if err := json.Unmarshal(plaintext, &decoded); err != nil {
+		t.Fatalf("failed to decode entries as v1 schema: %v", err)
+	}
+	if len(decoded) != 1 || decoded[0].Title != "Entry 1" {
+		t.Errorf("Unexpected decoded entries: %+v", decoded)
+	}
+}
+
+func TestExportCompatRejectsUnknownOrNewerVersion(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	mekBytes, cleanup, err := v.mek.Bytes()
+	if err != nil {
+		t.Fatalf("failed to read MEK: %v", err)
+	}
+	defer cleanup()
+
+	var buf bytes.Buffer
+	if err := ExportCompat(&buf, v.Entries(), CurrentEntryFormatVersion+1, mekBytes); err != ErrUnsupportedExportVersion {
+		t.Errorf("Expected ErrUnsupportedExportVersion for a newer target, got %v", err)
+	}
+	if err := ExportCompat(&buf, v.Entries(), 0, mekBytes); err != ErrUnsupportedExportVersion {
+		t.Errorf("Expected ErrUnsupportedExportVersion for version 0, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// redactedEntry is the shape ExportRedacted writes per entry: structural
+// fields only, with every secret-bearing field removed rather than masked,
+// so a bug report built from it can't leak a password by accident.
+type redactedEntry struct {
+	ID               string   `json:"id"`
+	Title            string   `json:"title"`
+	URL              string   `json:"url,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+	HasTOTP          bool     `json:"has_totp"`
+	IsArchived       bool     `json:"archived,omitempty"`
+	CustomFieldNames []string `json:"custom_field_names,omitempty"`
+}
+
+// ExportRedacted writes entries to w as JSON with every secret-bearing
+// field (Password, TOTPSecret, Notes, and any sensitive custom field)
+// removed, keeping only structure useful for a bug report: title, URL,
+// tags, and whether TOTP is configured. Non-sensitive custom field names
+// are kept (without their values); sensitive ones are dropped entirely.
+func ExportRedacted(w io.Writer, entries EntryList) error {
+	redacted := make([]redactedEntry, len(entries))
+	for i, e := range entries {
+		var fieldNames []string
+		for _, f := range e.CustomFields {
+			if !f.Sensitive {
+				fieldNames = append(fieldNames, f.Name)
+			}
+		}
+
+		redacted[i] = redactedEntry{
+			ID:               e.ID,
+			Title:            e.Title,
+			URL:              e.URL,
+			Tags:             e.Tags,
+			HasTOTP:          e.HasTOTP(),
+			IsArchived:       e.IsArchived,
+			CustomFieldNames: fieldNames,
+		}
+	}
+
+	data, err := json.MarshalIndent(redacted, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal redacted export: %w", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
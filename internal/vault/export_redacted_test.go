@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExportRedactedOmitsSecrets(t *testing.T) {
+	e := NewEntry("Entry 1")
+	e.Username = "alice"
+	e.Password = "super-secret-password"
+	e.Notes = "some private notes"
+	e.TOTPSecret = "JBSWY3DPEHPK3PXP"
+	e.URL = "https://example.com"
+	e.Tags = []string{"work"}
+	e.CustomFields = []CustomField{
+		{Name: "Card Number", Value: "4111111111111111", Sensitive: true},
+		{Name: "Bank Name", Value: "Example Bank"},
+	}
+
+	var buf bytes.Buffer
+	if err := ExportRedacted(&buf, EntryList{e}); err != nil {
+		t.Fatalf("ExportRedacted failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"super-secret-password", "some private notes", "JBSWY3DPEHPK3PXP", "4111111111111111"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Expected redacted export to omit %q, got %s", secret, out)
+		}
+	}
+
+	for _, want := range []string{"Entry 1", "https://example.com", "work", "Bank Name"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected redacted export to preserve %q, got %s", want, out)
+		}
+	}
+	if strings.Contains(out, "Card Number") {
+		t.Errorf("Expected sensitive custom field name to be dropped, got %s", out)
+	}
+}
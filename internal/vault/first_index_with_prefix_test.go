@@ -0,0 +1,46 @@
+package vault
+
+import "testing"
+
+func TestFirstIndexWithPrefixFindsMatch(t *testing.T) {
+	el := EntryList{
+		NewEntry("Amazon"),
+		NewEntry("Bank"),
+		NewEntry("Brokerage"),
+	}
+
+	idx := el.FirstIndexWithPrefix("b", 0)
+	if idx != 1 {
+		t.Errorf("Expected index 1, got %d", idx)
+	}
+}
+
+func TestFirstIndexWithPrefixWrapsFromEnd(t *testing.T) {
+	el := EntryList{
+		NewEntry("Amazon"),
+		NewEntry("Bank"),
+		NewEntry("Brokerage"),
+	}
+
+	idx := el.FirstIndexWithPrefix("a", len(el)-1)
+	if idx != 0 {
+		t.Errorf("Expected wrap-around to index 0, got %d", idx)
+	}
+}
+
+func TestFirstIndexWithPrefixNoMatch(t *testing.T) {
+	el := EntryList{NewEntry("Amazon"), NewEntry("Bank")}
+
+	if idx := el.FirstIndexWithPrefix("z", 0); idx != -1 {
+		t.Errorf("Expected -1 for no match, got %d", idx)
+	}
+}
+
+func TestFirstIndexWithPrefixCaseInsensitive(t *testing.T) {
+	el := EntryList{NewEntry("amazon"), NewEntry("Bank")}
+
+	idx := el.FirstIndexWithPrefix("AM", len(el)-1)
+	if idx != 0 {
+		t.Errorf("Expected case-insensitive match at index 0, got %d", idx)
+	}
+}
@@ -0,0 +1,23 @@
+package vault
+
+import "strings"
+
+// columnIndex maps lowercased CSV header names to their column index, so
+// importers can look columns up by name instead of assuming a fixed order.
+func columnIndex(header []string) map[string]int {
+	idx := make(map[string]int, len(header))
+	for i, name := range header {
+		idx[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	return idx
+}
+
+// csvField returns the trimmed value of the named column in record, or ""
+// if the column is absent from the header or the record is short.
+func csvField(record []string, cols map[string]int, name string) string {
+	i, ok := cols[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+// Import1PasswordCSV imports entries from a 1Password CSV export with columns
+// Title, URL, Username, Password, Notes, OTPAuth (column order is read from
+// the header row, not assumed). Rows with no username or password become
+// notes-only entries. Rows that fail to parse are skipped and their errors
+// collected rather than aborting the whole import.
+func Import1PasswordCSV(r io.Reader) (EntryList, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return EntryList{}, nil
+		}
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	cols := columnIndex(header)
+
+	var entries EntryList
+	var errs []error
+	row := 1
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", row, err))
+			continue
+		}
+
+		title := csvField(record, cols, "title")
+		if title == "" {
+			errs = append(errs, fmt.Errorf("row %d: missing title", row))
+			continue
+		}
+
+		entry := NewEntry(title)
+		entry.Username = csvField(record, cols, "username")
+		entry.Password = csvField(record, cols, "password")
+		entry.URL = csvField(record, cols, "url")
+		entry.Notes = csvField(record, cols, "notes")
+
+		if otpauth := csvField(record, cols, "otpauth"); otpauth != "" {
+			secret, _, _, err := totp.ParseOTPAuthURL(otpauth)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("row %d: invalid OTPAuth: %w", row, err))
+			} else {
+				entry.TOTPSecret = secret
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, errors.Join(errs...)
+}
@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImport1PasswordCSV(t *testing.T) {
+	csvData := `Title,URL,Username,Password,Notes,OTPAuth
+GitHub,https://github.com,alice,hunt3r2-secure,Work account,otpauth://totp/GitHub:alice?secret=JBSWY3DPEHPK3PXP&issuer=GitHub
+Wi-Fi Recovery Key,,,,"Keep this safe",`
+
+	entries, err := Import1PasswordCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("Import1PasswordCSV failed: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+
+	github := entries[0]
+	if github.Username != "alice" || github.Password != "hunt3r2-secure" {
+		t.Errorf("Unexpected GitHub entry: %+v", github)
+	}
+	if !github.HasTOTP() {
+		t.Error("Expected GitHub entry to have a TOTP secret")
+	}
+
+	note := entries[1]
+	if note.Username != "" || note.Password != "" {
+		t.Errorf("Expected secure note entry with no credentials, got %+v", note)
+	}
+	if note.Notes != "Keep this safe" {
+		t.Errorf("Expected notes to be preserved, got %q", note.Notes)
+	}
+}
+
+func TestImport1PasswordCSVCollectsRowErrors(t *testing.T) {
+	csvData := `Title,URL,Username,Password,Notes,OTPAuth
+,https://example.com,bob,pw,,
+Valid Entry,https://example.com,bob,pw,,`
+
+	entries, err := Import1PasswordCSV(strings.NewReader(csvData))
+	if err == nil {
+		t.Fatal("Expected an error for the row with a missing title")
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Expected the valid row to still be imported, got %d entries", len(entries))
+	}
+	if entries[0].Title != "Valid Entry" {
+		t.Errorf("Expected the valid entry to be imported, got %q", entries[0].Title)
+	}
+}
@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+// secureNoteURL is the placeholder URL LastPass writes for secure note entries.
+const secureNoteURL = "http://sn"
+
+// ImportLastPassCSV imports entries from a LastPass CSV export with columns
+// url, username, password, extra, name, grouping, totp (column order is read
+// from the header row, not assumed). The grouping column becomes a tag, and
+// rows with the "http://sn" placeholder URL become notes-only entries. Rows
+// that fail to parse are skipped and their errors collected rather than
+// aborting the whole import.
+func ImportLastPassCSV(r io.Reader) (EntryList, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return EntryList{}, nil
+		}
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	cols := columnIndex(header)
+
+	var entries EntryList
+	var errs []error
+	row := 1
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", row, err))
+			continue
+		}
+
+		name := csvField(record, cols, "name")
+		if name == "" {
+			errs = append(errs, fmt.Errorf("row %d: missing name", row))
+			continue
+		}
+
+		entry := NewEntry(name)
+		entry.Username = csvField(record, cols, "username")
+		entry.Password = csvField(record, cols, "password")
+		entry.Notes = csvField(record, cols, "extra")
+
+		if url := csvField(record, cols, "url"); url != secureNoteURL {
+			entry.URL = url
+		}
+
+		if grouping := csvField(record, cols, "grouping"); grouping != "" {
+			entry.Tags = []string{grouping}
+		}
+
+		if secret := csvField(record, cols, "totp"); secret != "" {
+			secret = totp.NormalizeSecret(secret)
+			if !totp.ValidateSecret(secret) {
+				errs = append(errs, fmt.Errorf("row %d: invalid totp secret", row))
+			} else {
+				entry.TOTPSecret = secret
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, errors.Join(errs...)
+}
@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImportLastPassCSV(t *testing.T) {
+	csvData := `url,username,password,extra,name,grouping,totp
+https://github.com,alice,hunt3r2-secure,Work account,GitHub,Dev,JBSWY3DPEHPK3PXP
+http://sn,,,"Keep this safe",Wi-Fi Recovery Key,Home,`
+
+	entries, err := ImportLastPassCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("ImportLastPassCSV failed: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+
+	github := entries[0]
+	if github.URL != "https://github.com" || !github.HasTOTP() {
+		t.Errorf("Unexpected GitHub entry: %+v", github)
+	}
+	if len(github.Tags) != 1 || github.Tags[0] != "Dev" {
+		t.Errorf("Expected grouping to become tag 'Dev', got %v", github.Tags)
+	}
+
+	note := entries[1]
+	if note.URL != "" {
+		t.Errorf("Expected secure note URL to be cleared, got %q", note.URL)
+	}
+	if note.Notes != "Keep this safe" {
+		t.Errorf("Expected notes to be preserved, got %q", note.Notes)
+	}
+}
+
+func TestImportLastPassCSVCollectsRowErrors(t *testing.T) {
+	csvData := `url,username,password,extra,name,grouping,totp
+https://example.com,bob,pw,,,,
+https://example.com,bob,pw,,Valid Entry,,`
+
+	entries, err := ImportLastPassCSV(strings.NewReader(csvData))
+	if err == nil {
+		t.Fatal("Expected an error for the row with a missing name")
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Expected the valid row to still be imported, got %d entries", len(entries))
+	}
+}
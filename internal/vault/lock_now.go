@@ -0,0 +1,24 @@
+package vault
+
+// RevealCanceler cancels any pending "reveal" timers the caller is tracking
+// (e.g. a scheduled re-mask for a temporarily displayed password). Lock and
+// LockNow cannot see UI-side timers themselves, so callers that schedule
+// reveals must supply one.
+type RevealCanceler interface {
+	CancelReveal()
+}
+
+// LockNow performs an explicit, user-initiated lock: it cancels any pending
+// reveal timer, then locks the vault exactly as Lock does (flushing unsaved
+// changes, destroying the MEK, and clearing the clipboard). reveal may be
+// nil if the caller has nothing to cancel.
+//
+// Resetting UI-only state such as an active search query is outside the
+// vault's knowledge and remains the caller's responsibility after LockNow
+// returns.
+func (v *Vault) LockNow(reveal RevealCanceler) {
+	if reveal != nil {
+		reveal.CancelReveal()
+	}
+	v.Lock()
+}
@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+type fakeRevealCanceler struct {
+	calls int
+}
+
+func (f *fakeRevealCanceler) CancelReveal() {
+	f.calls++
+}
+
+func TestLockNowCancelsRevealAndLocksExactlyOnce(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	reveal := &fakeRevealCanceler{}
+	v.LockNow(reveal)
+
+	if reveal.calls != 1 {
+		t.Fatalf("Expected CancelReveal to be called exactly once, got %d", reveal.calls)
+	}
+	if !v.IsLocked() {
+		t.Fatal("Expected vault to be locked after LockNow")
+	}
+}
+
+func TestLockNowWithNilRevealStillLocks(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	v.LockNow(nil)
+
+	if !v.IsLocked() {
+		t.Fatal("Expected vault to be locked after LockNow with nil reveal")
+	}
+}
@@ -0,0 +1,75 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import (
+	"encoding/json"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+// NewMemoryStore creates a vault exactly like Create, except it never reads
+// or writes a file (or touches the OS keyring): Save just re-encrypts into
+// the in-memory Database. It exercises the same real encryption as a
+// file-backed vault, so it's suitable for demos and tests that shouldn't
+// leave anything on disk.
+func NewMemoryStore(password string) (*Vault, string, error) {
+	bundle, phrase, err := crypto.CreateMEKBundle(password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data := &VaultData{
+		Entries: make(EntryList, 0),
+	}
+
+	mekBytes, err := bundle.DecryptMEKWithPassword(password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	db, err := NewDatabase(
+		bundle.SaltPassword,
+		bundle.SaltPhrase,
+		bundle.EncryptedMEKPassword,
+		bundle.EncryptedMEKPhrase,
+		nil, // encrypted data will be set below
+		bundle.SaltDuress,
+		bundle.EncryptedMEKDuress,
+		nil, // no decoy vault data for an in-memory vault's filler slot
+		nil, // NewMemoryStore never backs up the keyring secret
+		SecurityModePasswordKeyring,
+		"", // no keyring is consulted for an in-memory vault
+	)
+	if err != nil {
+		crypto.ZeroMemory(mekBytes)
+		return nil, "", err
+	}
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		crypto.ZeroMemory(mekBytes)
+		return nil, "", err
+	}
+
+	aad := db.BuildAAD()
+	encryptedData, err := crypto.EncryptWithAAD(dataJSON, mekBytes, aad)
+	if err != nil {
+		crypto.ZeroMemory(mekBytes)
+		return nil, "", err
+	}
+	db.SetEncryptedData(encryptedData)
+
+	mek := crypto.NewSecureMEK(mekBytes)
+
+	v := &Vault{
+		mek:           mek,
+		db:            db,
+		data:          data,
+		modified:      false,
+		inMemory:      true,
+		saveDebounce:  DefaultSaveDebounce,
+		debounceClock: realDebounceClock{},
+	}
+
+	return v, phrase, nil
+}
@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMemoryStoreRoundTrip(t *testing.T) {
+	resetMockKeyring()
+
+	v, _, err := NewMemoryStore("password")
+	if err != nil {
+		t.Fatalf("NewMemoryStore failed: %v", err)
+	}
+
+	entry := NewEntry("Entry 1")
+	if err := v.AddEntry(entry); err != nil {
+		t.Fatalf("AddEntry failed: %v", err)
+	}
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	if v.EntryCount() != 1 {
+		t.Errorf("Expected 1 entry, got %d", v.EntryCount())
+	}
+
+	got, err := v.GetEntry(entry.ID)
+	if err != nil {
+		t.Fatalf("GetEntry failed: %v", err)
+	}
+	if got.Title != "Entry 1" {
+		t.Errorf("Expected title 'Entry 1', got %q", got.Title)
+	}
+}
+
+func TestMemoryStoreCreatesNoFiles(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+
+	v, _, err := NewMemoryStore("password")
+	if err != nil {
+		t.Fatalf("NewMemoryStore failed: %v", err)
+	}
+	v.AddEntry(NewEntry("Entry 1"))
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Expected no files created, found %d", len(entries))
+	}
+}
@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+// ErrNotesNotExternalized is returned by OpenNotes when called on an entry
+// whose notes were never sealed with SealNotes.
+var ErrNotesNotExternalized = errors.New("entry notes are not externalized")
+
+// notesSubkey derives a notes-specific encryption subkey from mek via
+// HKDF-SHA256, scoped to e's ID, so compromising one entry's notes subkey
+// doesn't expose any other entry's notes or the MEK itself.
+func notesSubkey(mek []byte, e *Entry) ([]byte, error) {
+	reader := hkdf.New(sha256.New, mek, nil, []byte("notes:"+e.ID))
+	subkey := make([]byte, crypto.KeySize)
+	if _, err := io.ReadFull(reader, subkey); err != nil {
+		return nil, err
+	}
+	return subkey, nil
+}
+
+// SealNotes encrypts notes under a subkey derived from mek and e.ID,
+// storing the result in e.NotesSealed and marking e.NotesExternalized, so a
+// large note can be edited without re-encrypting the whole entry blob
+// alongside it. e.Notes is cleared so the plaintext doesn't also linger in
+// the entry. Calls e.Update().
+func SealNotes(mek []byte, e *Entry, notes string) error {
+	subkey, err := notesSubkey(mek, e)
+	if err != nil {
+		return err
+	}
+	defer crypto.ZeroMemory(subkey)
+
+	ciphertext, err := crypto.Encrypt([]byte(notes), subkey)
+	if err != nil {
+		return err
+	}
+
+	e.NotesSealed = base64.StdEncoding.EncodeToString(ciphertext)
+	e.NotesExternalized = true
+	e.Notes = ""
+	e.Update()
+	return nil
+}
+
+// OpenNotes returns e's plaintext notes, decrypting NotesSealed with a
+// subkey derived from mek if e.NotesExternalized, or returning e.Notes
+// directly otherwise, so callers don't need to branch on whether an entry's
+// notes happen to be externalized.
+func OpenNotes(mek []byte, e *Entry) (string, error) {
+	if !e.NotesExternalized {
+		return e.Notes, nil
+	}
+	if e.NotesSealed == "" {
+		return "", ErrNotesNotExternalized
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(e.NotesSealed)
+	if err != nil {
+		return "", err
+	}
+
+	subkey, err := notesSubkey(mek, e)
+	if err != nil {
+		return "", err
+	}
+	defer crypto.ZeroMemory(subkey)
+
+	plaintext, err := crypto.Decrypt(ciphertext, subkey)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,46 @@
+package vault
+
+import "testing"
+
+func TestSealNotesRoundTrip(t *testing.T) {
+	mek := make([]byte, 32)
+	for i := range mek {
+		mek[i] = byte(i)
+	}
+
+	e := NewEntry("GitHub")
+	const longNote = "a very long note that we'd rather not re-encrypt on every small field edit"
+
+	if err := SealNotes(mek, e, longNote); err != nil {
+		t.Fatalf("SealNotes failed: %v", err)
+	}
+	if !e.NotesExternalized {
+		t.Fatal("Expected NotesExternalized to be true")
+	}
+	if e.Notes != "" {
+		t.Fatalf("Expected Notes to be cleared, got %q", e.Notes)
+	}
+
+	got, err := OpenNotes(mek, e)
+	if err != nil {
+		t.Fatalf("OpenNotes failed: %v", err)
+	}
+	if got != longNote {
+		t.Fatalf("Expected %q, got %q", longNote, got)
+	}
+}
+
+func TestOpenNotesWithoutExternalizationReturnsPlainField(t *testing.T) {
+	mek := make([]byte, 32)
+
+	e := NewEntry("GitHub")
+	e.Notes = "a short note"
+
+	got, err := OpenNotes(mek, e)
+	if err != nil {
+		t.Fatalf("OpenNotes failed: %v", err)
+	}
+	if got != "a short note" {
+		t.Fatalf("Expected %q, got %q", "a short note", got)
+	}
+}
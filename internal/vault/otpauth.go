@@ -0,0 +1,16 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import "github.com/batterdaysahead/cipher0/internal/totp"
+
+// OTPAuthURL assembles an otpauth:// URL for e, suitable for sharing its
+// TOTP setup to another device (e.g. via QR code or clipboard). The entry's
+// title is used as the issuer and its username as the account, matching how
+// BuildOTPAuthURLWithConfig labels the code in an authenticator app.
+// Returns totp.ErrInvalidSecret if e has no usable TOTP secret.
+func (e *Entry) OTPAuthURL() (string, error) {
+	if !totp.ValidateSecret(e.TOTPSecret) {
+		return "", totp.ErrInvalidSecret
+	}
+	return totp.BuildOTPAuthURLWithConfig(e.TOTPSecret, e.Title, e.Username, e.totpConfig()), nil
+}
@@ -0,0 +1,39 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+func TestEntryOTPAuthURLRoundTrips(t *testing.T) {
+	e := NewEntry("GitHub")
+	e.Username = "alice@example.com"
+	e.TOTPSecret = "JBSWY3DPEHPK3PXP"
+
+	otpURL, err := e.OTPAuthURL()
+	if err != nil {
+		t.Fatalf("OTPAuthURL failed: %v", err)
+	}
+
+	secret, issuer, account, err := totp.ParseOTPAuthURL(otpURL)
+	if err != nil {
+		t.Fatalf("ParseOTPAuthURL failed: %v", err)
+	}
+	if secret != e.TOTPSecret {
+		t.Errorf("Expected secret %q, got %q", e.TOTPSecret, secret)
+	}
+	if issuer != e.Title {
+		t.Errorf("Expected issuer %q, got %q", e.Title, issuer)
+	}
+	if account != e.Username {
+		t.Errorf("Expected account %q, got %q", e.Username, account)
+	}
+}
+
+func TestEntryOTPAuthURLRejectsMissingSecret(t *testing.T) {
+	e := NewEntry("GitHub")
+	if _, err := e.OTPAuthURL(); err != totp.ErrInvalidSecret {
+		t.Errorf("Expected ErrInvalidSecret, got %v", err)
+	}
+}
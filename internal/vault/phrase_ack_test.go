@@ -0,0 +1,36 @@
+package vault
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveBlockedUntilPhraseAcknowledged(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	v.RequirePhraseAcknowledgement(true)
+
+	if err := v.Save(); err != ErrPhraseNotAcknowledged {
+		t.Fatalf("Expected ErrPhraseNotAcknowledged before acknowledgement, got %v", err)
+	}
+
+	v.AcknowledgePhrase()
+
+	if err := v.Save(); err != nil {
+		t.Fatalf("Expected Save to succeed after acknowledgement, got %v", err)
+	}
+}
+
+func TestSaveUnaffectedWhenNotRequired(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, _ := Create(vaultPath, "password")
+	if err := v.Save(); err != nil {
+		t.Fatalf("Expected Save to succeed by default, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package vault
+
+import "encoding/json"
+
+// ReEncryptProgress reports progress through a bulk re-encryption pass. done
+// increases monotonically from 1 to total.
+type ReEncryptProgress func(done, total int)
+
+// ReEncryptEntries walks entries in order, validating that each still
+// encodes cleanly, and reports progress after each one via progress (which
+// may be nil). It is meant to run ahead of a MEK rotation (e.g. from
+// ChangePassword) so the UI can render a progress bar (ui.RenderProgressBar)
+// on large vaults, rather than blocking silently.
+//
+// The vault persists all entries as a single blob encrypted under the MEK
+// in one call (see SaveDatabase/Compact) rather than encrypting each entry
+// individually, so there is no per-entry ciphertext to rotate here; this
+// pass exists to give large vaults incremental, monotonic feedback before
+// that single encrypt-and-save commits the new key.
+func ReEncryptEntries(entries EntryList, progress ReEncryptProgress) error {
+	total := len(entries)
+	for i, e := range entries {
+		if e != nil {
+			if _, err := json.Marshal(e); err != nil {
+				return err
+			}
+		}
+		if progress != nil {
+			progress(i+1, total)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package vault
+
+import "testing"
+
+func TestReEncryptEntriesCallsProgressMonotonicallyToTotal(t *testing.T) {
+	entries := EntryList{
+		NewEntry("a"),
+		NewEntry("b"),
+		NewEntry("c"),
+	}
+
+	var calls []int
+	err := ReEncryptEntries(entries, func(done, total int) {
+		calls = append(calls, done)
+		if total != len(entries) {
+			t.Fatalf("Expected total %d, got %d", len(entries), total)
+		}
+	})
+	if err != nil {
+		t.Fatalf("ReEncryptEntries failed: %v", err)
+	}
+
+	if len(calls) != len(entries) {
+		t.Fatalf("Expected %d progress calls, got %d", len(entries), len(calls))
+	}
+	for i, done := range calls {
+		if done != i+1 {
+			t.Fatalf("Expected call %d to report done=%d, got %d", i, i+1, done)
+		}
+	}
+	if calls[len(calls)-1] != len(entries) {
+		t.Fatalf("Expected final call to report done=total=%d, got %d", len(entries), calls[len(calls)-1])
+	}
+}
+
+func TestReEncryptEntriesHandlesNilProgress(t *testing.T) {
+	entries := EntryList{NewEntry("a")}
+	if err := ReEncryptEntries(entries, nil); err != nil {
+		t.Fatalf("ReEncryptEntries with nil progress failed: %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+package vault
+
+import "strings"
+
+// ReplaceUsername updates every entry in el whose Username equals old
+// (case-insensitive unless matchExact, in which case byte-exact) to new,
+// calling Update on each changed entry, and returns how many entries
+// changed. Entries whose Username doesn't match old are left untouched.
+func (el EntryList) ReplaceUsername(old, new string, matchExact bool) (count int) {
+	for _, e := range el {
+		matches := e.Username == old
+		if !matchExact {
+			matches = strings.EqualFold(e.Username, old)
+		}
+		if !matches {
+			continue
+		}
+		e.Username = new
+		e.Update()
+		count++
+	}
+	return count
+}
@@ -0,0 +1,45 @@
+package vault
+
+import "testing"
+
+func TestReplaceUsernameCaseInsensitiveByDefault(t *testing.T) {
+	a := NewEntry("GitHub")
+	a.Username = "Old@Example.com"
+	b := NewEntry("GitLab")
+	b.Username = "old@example.com"
+	c := NewEntry("Unrelated")
+	c.Username = "someone-else@example.com"
+
+	list := EntryList{a, b, c}
+	count := list.ReplaceUsername("old@example.com", "new@example.com", false)
+
+	if count != 2 {
+		t.Fatalf("Expected 2 entries changed, got %d", count)
+	}
+	if a.Username != "new@example.com" || b.Username != "new@example.com" {
+		t.Fatalf("Expected matching entries updated, got %q and %q", a.Username, b.Username)
+	}
+	if c.Username != "someone-else@example.com" {
+		t.Fatalf("Expected non-matching entry untouched, got %q", c.Username)
+	}
+}
+
+func TestReplaceUsernameExactMatchIsCaseSensitive(t *testing.T) {
+	a := NewEntry("GitHub")
+	a.Username = "Old@Example.com"
+	b := NewEntry("GitLab")
+	b.Username = "old@example.com"
+
+	list := EntryList{a, b}
+	count := list.ReplaceUsername("old@example.com", "new@example.com", true)
+
+	if count != 1 {
+		t.Fatalf("Expected 1 entry changed, got %d", count)
+	}
+	if b.Username != "new@example.com" {
+		t.Fatalf("Expected exact-match entry updated, got %q", b.Username)
+	}
+	if a.Username != "Old@Example.com" {
+		t.Fatalf("Expected differently-cased entry untouched, got %q", a.Username)
+	}
+}
@@ -0,0 +1,69 @@
+package vault
+
+import "github.com/batterdaysahead/cipher0/internal/utils"
+
+// SecurityReport summarizes password hygiene across a set of entries: which
+// ones have a weak or fair password, and which share an identical password
+// with one or more other entries.
+type SecurityReport struct {
+	// TotalEntries is the number of entries the report was computed over.
+	TotalEntries int
+	// WeakEntryIDs lists entries whose password strength is weak or fair.
+	WeakEntryIDs []string
+	// ReusedPasswords maps a password to the IDs of every entry that uses
+	// it, for passwords shared by two or more entries.
+	ReusedPasswords map[string][]string
+}
+
+// SecurityReport computes a SecurityReport over every entry in el.
+func (el EntryList) SecurityReport() SecurityReport {
+	return computeSecurityReport(el)
+}
+
+// SecurityReportFor computes a SecurityReport scoped to the entries whose
+// ID appears in ids (e.g. a folder or tag selection), reusing the same
+// computation as SecurityReport so a scoped audit of a large vault doesn't
+// need to walk every entry.
+func (el EntryList) SecurityReportFor(ids []string) SecurityReport {
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	subset := make(EntryList, 0, len(ids))
+	for _, e := range el {
+		if e != nil && wanted[e.ID] {
+			subset = append(subset, e)
+		}
+	}
+
+	return computeSecurityReport(subset)
+}
+
+// computeSecurityReport is the shared implementation behind SecurityReport
+// and SecurityReportFor.
+func computeSecurityReport(entries EntryList) SecurityReport {
+	report := SecurityReport{
+		TotalEntries:    len(entries),
+		ReusedPasswords: make(map[string][]string),
+	}
+
+	byPassword := make(map[string][]string)
+	for _, e := range entries {
+		if e == nil || e.Password == "" {
+			continue
+		}
+		if utils.CalculateStrength(e.Password) <= utils.StrengthFair {
+			report.WeakEntryIDs = append(report.WeakEntryIDs, e.ID)
+		}
+		byPassword[e.Password] = append(byPassword[e.Password], e.ID)
+	}
+
+	for password, ids := range byPassword {
+		if len(ids) > 1 {
+			report.ReusedPasswords[password] = ids
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSecurityReportFindsWeakAndReusedPasswords(t *testing.T) {
+	weak := NewEntry("Weak")
+	weak.Password = "abc"
+
+	reusedA := NewEntry("Reused A")
+	reusedA.Password = "Sup3r$ecureReused!"
+	reusedB := NewEntry("Reused B")
+	reusedB.Password = "Sup3r$ecureReused!"
+
+	clean := NewEntry("Clean")
+	clean.Password = "AnotherSafeOne#42xyz"
+
+	entries := EntryList{weak, reusedA, reusedB, clean}
+	report := entries.SecurityReport()
+
+	if report.TotalEntries != 4 {
+		t.Fatalf("Expected TotalEntries 4, got %d", report.TotalEntries)
+	}
+	if !containsID(report.WeakEntryIDs, weak.ID) {
+		t.Fatalf("Expected weak entry to be flagged, got %v", report.WeakEntryIDs)
+	}
+	ids := report.ReusedPasswords[reusedA.Password]
+	if !containsID(ids, reusedA.ID) || !containsID(ids, reusedB.ID) {
+		t.Fatalf("Expected both reused entries listed, got %v", ids)
+	}
+}
+
+func TestSecurityReportForMatchesManualSubset(t *testing.T) {
+	a := NewEntry("A")
+	a.Password = "weak"
+	b := NewEntry("B")
+	b.Password = "weak"
+	c := NewEntry("C")
+	c.Password = "Unrelated$trongPassw0rd"
+
+	entries := EntryList{a, b, c}
+
+	scoped := entries.SecurityReportFor([]string{a.ID, b.ID})
+	manual := computeSecurityReport(EntryList{a, b})
+
+	if !reflect.DeepEqual(scoped, manual) {
+		t.Fatalf("Expected scoped report to match manual subset report.\nscoped=%+v\nmanual=%+v", scoped, manual)
+	}
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
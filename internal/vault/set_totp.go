@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+// SetTOTP sets e's TOTP secret from input, which may be either a bare
+// base32 secret or a full otpauth:// URL as pasted from an authenticator
+// app's "manual entry" or QR-scan output. A URL's secret is extracted and
+// normalized before being stored, so ValidateSecret sees a clean secret
+// rather than the URL verbatim. Any non-default algorithm/digits/period the
+// URL specifies is stored on e too (see totpConfig), so GenerateTOTPCode
+// produces codes the target service actually accepts.
+func SetTOTP(e *Entry, input string) error {
+	input = strings.TrimSpace(input)
+
+	if !totp.IsOTPAuthURL(input) {
+		secret := totp.NormalizeSecret(input)
+		if !totp.ValidateSecret(secret) {
+			return totp.ErrInvalidSecret
+		}
+		e.TOTPSecret = secret
+		e.TOTPAlgorithm = ""
+		e.TOTPDigits = 0
+		e.TOTPPeriod = 0
+		return nil
+	}
+
+	secret, _, _, err := totp.ParseOTPAuthURL(input)
+	if err != nil {
+		return err
+	}
+
+	cfg := nonDefaultTOTPConfig(input)
+
+	e.TOTPSecret = secret
+	e.TOTPAlgorithm = cfg.Algorithm
+	e.TOTPDigits = cfg.Digits
+	e.TOTPPeriod = cfg.Period
+	return nil
+}
+
+// nonDefaultTOTPConfig extracts rawURL's algorithm, digits, and period
+// query parameters (if present and non-default) into a totp.TOTPConfig;
+// zero fields mean "use the package defaults", same as SetTOTP never having
+// seen a URL at all.
+func nonDefaultTOTPConfig(rawURL string) totp.TOTPConfig {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return totp.TOTPConfig{}
+	}
+
+	q := u.Query()
+	var cfg totp.TOTPConfig
+
+	if alg := q.Get("algorithm"); alg != "" && !strings.EqualFold(alg, totp.DefaultAlgorithm) {
+		cfg.Algorithm = strings.ToUpper(alg)
+	}
+	if digits := q.Get("digits"); digits != "" {
+		if n, err := strconv.Atoi(digits); err == nil && n != totp.DefaultDigits {
+			cfg.Digits = n
+		}
+	}
+	if period := q.Get("period"); period != "" {
+		if n, err := strconv.Atoi(period); err == nil && n != totp.DefaultPeriod {
+			cfg.Period = n
+		}
+	}
+
+	return cfg
+}
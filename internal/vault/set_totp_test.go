@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+func TestSetTOTPAcceptsBareSecret(t *testing.T) {
+	e := NewEntry("GitHub")
+
+	if err := SetTOTP(e, "jbswy3dpehpk3pxp"); err != nil {
+		t.Fatalf("SetTOTP failed: %v", err)
+	}
+	if e.TOTPSecret != "JBSWY3DPEHPK3PXP" {
+		t.Fatalf("Expected normalized secret, got %q", e.TOTPSecret)
+	}
+}
+
+func TestSetTOTPAcceptsOTPAuthURL(t *testing.T) {
+	e := NewEntry("GitHub")
+	url := totp.BuildOTPAuthURL("JBSWY3DPEHPK3PXP", "GitHub", "alice")
+
+	if err := SetTOTP(e, url); err != nil {
+		t.Fatalf("SetTOTP failed: %v", err)
+	}
+	if e.TOTPSecret != "JBSWY3DPEHPK3PXP" {
+		t.Fatalf("Expected extracted secret, got %q", e.TOTPSecret)
+	}
+}
+
+func TestSetTOTPRejectsInvalidSecret(t *testing.T) {
+	e := NewEntry("GitHub")
+
+	if err := SetTOTP(e, "not-a-secret"); err == nil {
+		t.Fatal("Expected error for invalid secret")
+	}
+}
+
+func TestSetTOTPStoresNonDefaultConfig(t *testing.T) {
+	e := NewEntry("GitHub")
+	url := totp.BuildOTPAuthURLWithConfig("JBSWY3DPEHPK3PXP", "GitHub", "alice", totp.TOTPConfig{
+		Algorithm: "SHA256",
+		Digits:    8,
+	})
+
+	if err := SetTOTP(e, url); err != nil {
+		t.Fatalf("SetTOTP failed: %v", err)
+	}
+	if e.TOTPAlgorithm != "SHA256" || e.TOTPDigits != 8 {
+		t.Fatalf("Expected non-default config to be stored, got algorithm=%q digits=%d", e.TOTPAlgorithm, e.TOTPDigits)
+	}
+}
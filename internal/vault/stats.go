@@ -0,0 +1,54 @@
+package vault
+
+import "time"
+
+// VaultStats summarizes an EntryList for a vault overview screen.
+type VaultStats struct {
+	TotalEntries       int
+	WithTOTP           int
+	WithURL            int
+	AveragePasswordLen float64
+	OldestEntry        time.Time
+	NewestEntry        time.Time
+	UniqueTags         int
+}
+
+// Stats computes summary statistics over the entry list. An empty list
+// returns a zero-valued VaultStats.
+func (el EntryList) Stats() VaultStats {
+	var stats VaultStats
+	if len(el) == 0 {
+		return stats
+	}
+
+	stats.TotalEntries = len(el)
+
+	var totalPasswordLen int
+	tags := make(map[string]struct{})
+
+	for i, e := range el {
+		if e.HasTOTP() {
+			stats.WithTOTP++
+		}
+		if e.URL != "" {
+			stats.WithURL++
+		}
+		totalPasswordLen += len(e.Password)
+
+		for _, tag := range e.Tags {
+			tags[tag] = struct{}{}
+		}
+
+		if i == 0 || e.Created.Before(stats.OldestEntry) {
+			stats.OldestEntry = e.Created
+		}
+		if i == 0 || e.Created.After(stats.NewestEntry) {
+			stats.NewestEntry = e.Created
+		}
+	}
+
+	stats.AveragePasswordLen = float64(totalPasswordLen) / float64(len(el))
+	stats.UniqueTags = len(tags)
+
+	return stats
+}
@@ -0,0 +1,43 @@
+package vault
+
+import "testing"
+
+func TestEntryListStatsEmpty(t *testing.T) {
+	var el EntryList
+	stats := el.Stats()
+
+	if stats != (VaultStats{}) {
+		t.Errorf("Expected zeroed stats for an empty list, got %+v", stats)
+	}
+}
+
+func TestEntryListStats(t *testing.T) {
+	a := NewEntry("A")
+	a.Password = "12345678"
+	a.URL = "https://a.example.com"
+	a.TOTPSecret = "JBSWY3DPEHPK3PXP"
+	a.Tags = []string{"work"}
+
+	b := NewEntry("B")
+	b.Password = "1234"
+	b.Tags = []string{"work", "personal"}
+
+	el := EntryList{a, b}
+	stats := el.Stats()
+
+	if stats.TotalEntries != 2 {
+		t.Errorf("Expected 2 total entries, got %d", stats.TotalEntries)
+	}
+	if stats.WithTOTP != 1 {
+		t.Errorf("Expected 1 entry with TOTP, got %d", stats.WithTOTP)
+	}
+	if stats.WithURL != 1 {
+		t.Errorf("Expected 1 entry with a URL, got %d", stats.WithURL)
+	}
+	if stats.AveragePasswordLen != 6 {
+		t.Errorf("Expected average password length 6, got %v", stats.AveragePasswordLen)
+	}
+	if stats.UniqueTags != 2 {
+		t.Errorf("Expected 2 unique tags, got %d", stats.UniqueTags)
+	}
+}
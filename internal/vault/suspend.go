@@ -0,0 +1,15 @@
+package vault
+
+// OnSystemSuspend locks the vault in response to a detected system suspend
+// event. Platform-specific watchers (see suspend_linux.go and suspend_other.go)
+// call this when the OS reports the machine is about to sleep.
+func (v *Vault) OnSystemSuspend() {
+	v.Lock()
+}
+
+// WatchSystemSuspend starts the platform's suspend watcher (a no-op where
+// none is implemented) wired to call v.OnSystemSuspend when the system is
+// about to sleep. The returned stop function cancels the watch.
+func WatchSystemSuspend(v *Vault) (stop func(), err error) {
+	return startSuspendWatcher(v.OnSystemSuspend)
+}
@@ -0,0 +1,64 @@
+//go:build linux
+
+package vault
+
+import "github.com/godbus/dbus/v5"
+
+// prepareForSleepSignal is the fully-qualified name of systemd-logind's
+// suspend/resume broadcast signal.
+const prepareForSleepSignal = "org.freedesktop.login1.Manager.PrepareForSleep"
+
+// startSuspendWatcher listens for systemd-logind's PrepareForSleep signal
+// over the system DBus and calls onSuspend when the machine is about to
+// sleep.
+func startSuspendWatcher(onSuspend func()) (stop func(), err error) {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return func() {}, err
+	}
+
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchInterface("org.freedesktop.login1.Manager"),
+		dbus.WithMatchMember("PrepareForSleep"),
+	); err != nil {
+		conn.Close()
+		return func() {}, err
+	}
+
+	signals := make(chan *dbus.Signal, 8)
+	conn.Signal(signals)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case sig, ok := <-signals:
+				if !ok {
+					return
+				}
+				handleSuspendSignal(sig, onSuspend)
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		conn.Close()
+	}, nil
+}
+
+// handleSuspendSignal invokes onSuspend if sig is a PrepareForSleep signal
+// reporting that the machine is going to sleep (its boolean argument is
+// true going to sleep, false on resume). Split out from startSuspendWatcher
+// so the dispatch logic can be tested without a real DBus connection.
+func handleSuspendSignal(sig *dbus.Signal, onSuspend func()) {
+	if sig == nil || sig.Name != prepareForSleepSignal || len(sig.Body) == 0 {
+		return
+	}
+
+	if sleeping, ok := sig.Body[0].(bool); ok && sleeping {
+		onSuspend()
+	}
+}
@@ -0,0 +1,51 @@
+//go:build linux
+
+package vault
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+func TestHandleSuspendSignalTriggersOnSleep(t *testing.T) {
+	called := false
+	sig := &dbus.Signal{
+		Name: prepareForSleepSignal,
+		Body: []interface{}{true},
+	}
+
+	handleSuspendSignal(sig, func() { called = true })
+
+	if !called {
+		t.Error("Expected onSuspend to be called when going to sleep")
+	}
+}
+
+func TestHandleSuspendSignalIgnoresResume(t *testing.T) {
+	called := false
+	sig := &dbus.Signal{
+		Name: prepareForSleepSignal,
+		Body: []interface{}{false},
+	}
+
+	handleSuspendSignal(sig, func() { called = true })
+
+	if called {
+		t.Error("Expected onSuspend not to be called on resume")
+	}
+}
+
+func TestHandleSuspendSignalIgnoresOtherSignals(t *testing.T) {
+	called := false
+	sig := &dbus.Signal{
+		Name: "org.freedesktop.login1.Manager.SomethingElse",
+		Body: []interface{}{true},
+	}
+
+	handleSuspendSignal(sig, func() { called = true })
+
+	if called {
+		t.Error("Expected onSuspend not to be called for unrelated signals")
+	}
+}
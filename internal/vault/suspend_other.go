@@ -0,0 +1,11 @@
+//go:build !linux
+
+package vault
+
+// startSuspendWatcher is a no-op on platforms without a suspend watcher
+// implemented yet (macOS IOKit notifications and Windows power events are
+// tracked separately). Callers can still invoke v.OnSystemSuspend manually
+// until a watcher lands for these platforms.
+func startSuspendWatcher(onSuspend func()) (stop func(), err error) {
+	return func() {}, nil
+}
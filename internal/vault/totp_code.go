@@ -0,0 +1,24 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import "github.com/batterdaysahead/cipher0/internal/totp"
+
+// totpConfig assembles e's non-default TOTP parameters into a
+// totp.TOTPConfig, so callers that build codes or otpauth URLs for e use
+// the same settings that were parsed from its original otpauth URL (see
+// SetTOTP) instead of always falling back to the package defaults.
+func (e *Entry) totpConfig() totp.TOTPConfig {
+	return totp.TOTPConfig{
+		Algorithm: e.TOTPAlgorithm,
+		Digits:    e.TOTPDigits,
+		Period:    e.TOTPPeriod,
+	}
+}
+
+// GenerateTOTPCode generates the current TOTP code for e, using its
+// non-default algorithm/digits/period if SetTOTP recorded any, and the
+// package defaults otherwise. Returns the code, seconds remaining in the
+// current period, and any error.
+func (e *Entry) GenerateTOTPCode() (string, int, error) {
+	return totp.GenerateCodeWithConfig(e.TOTPSecret, e.totpConfig())
+}
@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+func TestGenerateTOTPCodeUsesEntryConfig(t *testing.T) {
+	e := NewEntry("GitHub")
+	e.TOTPSecret = "JBSWY3DPEHPK3PXP"
+	e.TOTPAlgorithm = "SHA256"
+	e.TOTPDigits = 8
+
+	code, _, err := e.GenerateTOTPCode()
+	if err != nil {
+		t.Fatalf("GenerateTOTPCode failed: %v", err)
+	}
+
+	want, _, err := totp.GenerateCodeWithConfig(e.TOTPSecret, totp.TOTPConfig{Algorithm: "SHA256", Digits: 8})
+	if err != nil {
+		t.Fatalf("GenerateCodeWithConfig failed: %v", err)
+	}
+	if code != want {
+		t.Fatalf("Expected code %q, got %q", want, code)
+	}
+	if len(code) != 8 {
+		t.Fatalf("Expected an 8-digit code, got %q", code)
+	}
+}
+
+func TestGenerateTOTPCodeDefaultsWhenUnset(t *testing.T) {
+	e := NewEntry("GitHub")
+	e.TOTPSecret = "JBSWY3DPEHPK3PXP"
+
+	code, _, err := e.GenerateTOTPCode()
+	if err != nil {
+		t.Fatalf("GenerateTOTPCode failed: %v", err)
+	}
+	if len(code) != 6 {
+		t.Fatalf("Expected default 6-digit code, got %q", code)
+	}
+}
@@ -0,0 +1,27 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import (
+	"github.com/batterdaysahead/cipher0/internal/config"
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+// TOTPCopyContent resolves what should be placed on the clipboard for e's
+// TOTP entry under mode, so the copy key behaves according to the user's
+// config.TOTPCopyMode preference instead of always copying the current
+// code. Returns totp.ErrInvalidSecret if e has no usable TOTP secret.
+func (e *Entry) TOTPCopyContent(mode config.TOTPCopyMode) (string, error) {
+	if !totp.ValidateSecret(e.TOTPSecret) {
+		return "", totp.ErrInvalidSecret
+	}
+
+	switch mode {
+	case config.TOTPCopySecret:
+		return totp.NormalizeSecret(e.TOTPSecret), nil
+	case config.TOTPCopyOTPAuthURL:
+		return e.OTPAuthURL()
+	default:
+		code, _, err := e.GenerateTOTPCode()
+		return code, err
+	}
+}
@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/config"
+	"github.com/batterdaysahead/cipher0/internal/totp"
+)
+
+func TestTOTPCopyContentModes(t *testing.T) {
+	e := NewEntry("GitHub")
+	e.Username = "alice"
+	e.TOTPSecret = "JBSWY3DPEHPK3PXP"
+
+	code, err := e.TOTPCopyContent(config.TOTPCopyCode)
+	if err != nil {
+		t.Fatalf("TOTPCopyCode failed: %v", err)
+	}
+	wantCode, _, err := totp.GenerateCode(e.TOTPSecret)
+	if err != nil {
+		t.Fatalf("GenerateCode failed: %v", err)
+	}
+	if code != wantCode {
+		t.Fatalf("Expected code %q, got %q", wantCode, code)
+	}
+
+	secret, err := e.TOTPCopyContent(config.TOTPCopySecret)
+	if err != nil {
+		t.Fatalf("TOTPCopySecret failed: %v", err)
+	}
+	if secret != totp.NormalizeSecret(e.TOTPSecret) {
+		t.Fatalf("Expected normalized secret, got %q", secret)
+	}
+
+	url, err := e.TOTPCopyContent(config.TOTPCopyOTPAuthURL)
+	if err != nil {
+		t.Fatalf("TOTPCopyOTPAuthURL failed: %v", err)
+	}
+	if !strings.HasPrefix(url, "otpauth://") {
+		t.Fatalf("Expected an otpauth:// URL, got %q", url)
+	}
+}
+
+func TestTOTPCopyContentRejectsInvalidSecret(t *testing.T) {
+	e := NewEntry("GitHub")
+	if _, err := e.TOTPCopyContent(config.TOTPCopyCode); err != totp.ErrInvalidSecret {
+		t.Fatalf("Expected ErrInvalidSecret, got %v", err)
+	}
+}
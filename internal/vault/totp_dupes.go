@@ -0,0 +1,28 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import "github.com/batterdaysahead/cipher0/internal/totp"
+
+// DuplicateTOTPSecrets groups entries that share a TOTP secret, keyed by the
+// normalized secret (see totp.NormalizeSecret), so differently formatted
+// copies of the same secret (spacing, case) still group together. Entries
+// without a TOTP secret are excluded. Secrets used by only one entry are
+// omitted from the result.
+func (el EntryList) DuplicateTOTPSecrets() map[string][]*Entry {
+	bySecret := make(map[string][]*Entry)
+	for _, e := range el {
+		if !e.HasTOTP() {
+			continue
+		}
+		normalized := totp.NormalizeSecret(e.TOTPSecret)
+		bySecret[normalized] = append(bySecret[normalized], e)
+	}
+
+	duplicates := make(map[string][]*Entry)
+	for secret, entries := range bySecret {
+		if len(entries) > 1 {
+			duplicates[secret] = entries
+		}
+	}
+	return duplicates
+}
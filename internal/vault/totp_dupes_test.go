@@ -0,0 +1,33 @@
+package vault
+
+import "testing"
+
+func TestDuplicateTOTPSecretsGroupsDifferentFormatting(t *testing.T) {
+	e1 := NewEntry("Entry 1")
+	e1.TOTPSecret = "jbsw y3dp ehpk 3pxp"
+	e2 := NewEntry("Entry 2")
+	e2.TOTPSecret = "JBSWY3DPEHPK3PXP"
+
+	dupes := EntryList{e1, e2}.DuplicateTOTPSecrets()
+	if len(dupes) != 1 {
+		t.Fatalf("Expected 1 duplicate group, got %d", len(dupes))
+	}
+	for _, entries := range dupes {
+		if len(entries) != 2 {
+			t.Errorf("Expected 2 entries in the duplicate group, got %d", len(entries))
+		}
+	}
+}
+
+func TestDuplicateTOTPSecretsExcludesDistinctAndEmpty(t *testing.T) {
+	e1 := NewEntry("Entry 1")
+	e1.TOTPSecret = "JBSWY3DPEHPK3PXP"
+	e2 := NewEntry("Entry 2")
+	e2.TOTPSecret = "KRUGS4ZANFZSAYJB"
+	e3 := NewEntry("Entry 3")
+
+	dupes := EntryList{e1, e2, e3}.DuplicateTOTPSecrets()
+	if len(dupes) != 0 {
+		t.Errorf("Expected no duplicate groups, got %d", len(dupes))
+	}
+}
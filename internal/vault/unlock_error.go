@@ -0,0 +1,73 @@
+// Package vault provides vault management for the password manager.
+package vault
+
+import (
+	"fmt"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+// UnlockReason categorizes why UnlockWithPassword/UnlockWithPhrase failed,
+// so the UI can show tailored guidance instead of a generic error.
+type UnlockReason int
+
+const (
+	// ReasonWrongCredentials means the password or phrase itself was wrong.
+	ReasonWrongCredentials UnlockReason = iota
+	// ReasonKeyringUnavailable means the OS keyring couldn't be read at all.
+	ReasonKeyringUnavailable
+	// ReasonKeyringMismatch means the OS keyring is reachable but holds a
+	// different secret than the one this vault was created with.
+	ReasonKeyringMismatch
+	// ReasonCorruptVault means credentials checked out but the encrypted
+	// vault data itself couldn't be decoded.
+	ReasonCorruptVault
+)
+
+// String returns a human-readable label for the reason.
+func (r UnlockReason) String() string {
+	switch r {
+	case ReasonWrongCredentials:
+		return "wrong credentials"
+	case ReasonKeyringUnavailable:
+		return "keyring unavailable"
+	case ReasonKeyringMismatch:
+		return "keyring mismatch"
+	case ReasonCorruptVault:
+		return "corrupt vault"
+	default:
+		return "unknown"
+	}
+}
+
+// UnlockError wraps an unlock failure with a classified Reason so callers
+// can distinguish "wrong password" from "keyring missing" from "corrupt
+// file" without string-matching the message.
+type UnlockError struct {
+	Reason UnlockReason
+	Err    error
+}
+
+func (e *UnlockError) Error() string {
+	return fmt.Sprintf("unlock failed (%s): %v", e.Reason, e.Err)
+}
+
+// Unwrap lets errors.Is/As match against the underlying sentinel (e.g.
+// ErrWrongPassword) through an UnlockError.
+func (e *UnlockError) Unwrap() error {
+	return e.Err
+}
+
+// classifyUnlockFailure wraps base (a sentinel like ErrWrongPassword) with
+// the reason most likely to explain it: a keyring read error takes
+// precedence, then a fingerprint mismatch against the vault's recorded
+// keyring, else the credentials themselves were wrong.
+func classifyUnlockFailure(db *Database, keyringErr error, base error) *UnlockError {
+	if keyringErr != nil {
+		return &UnlockError{Reason: ReasonKeyringUnavailable, Err: base}
+	}
+	if db.KeyringFingerprint != "" && !db.HasMatchingKeyring(crypto.GetKeyringFingerprint()) {
+		return &UnlockError{Reason: ReasonKeyringMismatch, Err: base}
+	}
+	return &UnlockError{Reason: ReasonWrongCredentials, Err: base}
+}
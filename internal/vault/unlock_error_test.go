@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/batterdaysahead/cipher0/internal/crypto"
+)
+
+func TestUnlockErrorWrongCredentials(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	v.Lock()
+
+	_, err = UnlockWithPassword(vaultPath, "wrong-password")
+	var unlockErr *UnlockError
+	if !errors.As(err, &unlockErr) {
+		t.Fatalf("Expected an *UnlockError, got %T: %v", err, err)
+	}
+	if unlockErr.Reason != ReasonWrongCredentials {
+		t.Errorf("Expected ReasonWrongCredentials, got %v", unlockErr.Reason)
+	}
+	if !errors.Is(err, ErrWrongPassword) {
+		t.Error("Expected errors.Is to still match ErrWrongPassword")
+	}
+}
+
+func TestUnlockErrorWrongPhrase(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	v.Lock()
+
+	_, err = UnlockWithPhrase(vaultPath, "wrong word list entirely bogus phrase value here okay sure yep")
+	var unlockErr *UnlockError
+	if !errors.As(err, &unlockErr) {
+		t.Fatalf("Expected an *UnlockError, got %T: %v", err, err)
+	}
+	if unlockErr.Reason != ReasonWrongCredentials {
+		t.Errorf("Expected ReasonWrongCredentials, got %v", unlockErr.Reason)
+	}
+}
+
+func TestUnlockErrorKeyringMismatch(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	v, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	v.Lock()
+
+	// Swap in a different (but still present) keyring secret, simulating a
+	// restore onto a machine whose keyring doesn't match the vault's.
+	if _, err := crypto.CreateKeyringSecret(); err != nil {
+		t.Fatalf("CreateKeyringSecret failed: %v", err)
+	}
+
+	_, err = UnlockWithPassword(vaultPath, "password")
+	var unlockErr *UnlockError
+	if !errors.As(err, &unlockErr) {
+		t.Fatalf("Expected an *UnlockError, got %T: %v", err, err)
+	}
+	if unlockErr.Reason != ReasonKeyringMismatch {
+		t.Errorf("Expected ReasonKeyringMismatch, got %v", unlockErr.Reason)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/batterdaysahead/cipher0/internal/crypto"
+	"github.com/batterdaysahead/cipher0/internal/utils"
 )
 
 var (
@@ -23,6 +24,10 @@ var (
 	ErrEntryNotFound = errors.New("entry not found")
 	// ErrDuplicateEntry is returned when adding a duplicate entry.
 	ErrDuplicateEntry = errors.New("entry with this ID already exists")
+	// ErrPhraseNotAcknowledged is returned by Save when
+	// RequirePhraseAcknowledgement is set and AcknowledgePhrase hasn't been
+	// called yet.
+	ErrPhraseNotAcknowledged = errors.New("recovery phrase has not been acknowledged yet")
 )
 
 // VaultData represents the decrypted vault data.
@@ -32,19 +37,94 @@ type VaultData struct {
 
 // Vault represents an unlocked password vault.
 type Vault struct {
-	mu       sync.RWMutex
-	path     string
-	mek      *crypto.SecureMEK
-	db       *Database
-	data     *VaultData
-	modified bool
+	mu        sync.RWMutex
+	path      string
+	mek       *crypto.SecureMEK
+	db        *Database
+	data      *VaultData
+	modified  bool
+	clipboard *utils.ClipboardManager
+
+	backupBeforeSave bool
+
+	// inMemory, when true, keeps saves in v.db/v.data only and never touches
+	// disk. Set by NewMemoryStore.
+	inMemory bool
+
+	// saveDebounce and debounceClock drive MarkDirty's coalesced auto-save;
+	// see debounce.go.
+	saveDebounce  time.Duration
+	debounceClock debounceClock
+	saveTimer     scheduledTimer
+
+	// requirePhraseAck and phraseAcknowledged implement the policy that
+	// blocks saving until the user confirms they've recorded their
+	// recovery phrase. See RequirePhraseAcknowledgement.
+	requirePhraseAck   bool
+	phraseAcknowledged bool
+
+	// usingDuress is true when this Vault was opened via the duress
+	// password (see UnlockWithPassword), so it's really operating on the
+	// decoy data in db.EncryptedDataDuress. saveLocked checks this to
+	// write back to the right slot, and real vault data is never loaded
+	// into v.data in this case.
+	usingDuress bool
+}
+
+// SetClipboardManager wires a clipboard manager so Lock clears any copied
+// secret immediately, regardless of the clipboard's own auto-clear timer.
+func (v *Vault) SetClipboardManager(cm *utils.ClipboardManager) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.clipboard = cm
+}
+
+// SetBackupBeforeSave controls whether a rolling ".bak" copy of the vault
+// file is written before every save.
+func (v *Vault) SetBackupBeforeSave(enabled bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.backupBeforeSave = enabled
 }
 
 // Create creates a new vault with the given password.
 // Returns the vault, recovery phrase, and any error.
 // The recovery phrase must be shown to the user and is not stored.
 func Create(path, password string) (*Vault, string, error) {
-	bundle, phrase, err := crypto.CreateMEKBundle(password)
+	return createVault(path, password, "", false)
+}
+
+// CreateWithDuress is Create plus a duress password: entering duressPassword
+// instead of password at the login screen unlocks a separate, empty decoy
+// vault instead of the real one (see UnlockWithPassword and
+// crypto.CreateDuressBundle). The duress password can also be added later
+// via Vault.SetDuressPassword.
+func CreateWithDuress(path, password, duressPassword string) (*Vault, string, error) {
+	return createVault(path, password, duressPassword, false)
+}
+
+// CreateWithKeyringBackup is Create plus an encrypted backup of the keyring
+// secret under the recovery phrase (see crypto.CreateMEKBundleWithKeyringBackup),
+// so RecoverKeyringSecret can restore it on reinstall instead of leaving the
+// recovery phrase as the only way back in.
+func CreateWithKeyringBackup(path, password string) (*Vault, string, error) {
+	return createVault(path, password, "", true)
+}
+
+func createVault(path, password, duressPassword string, backupKeyring bool) (*Vault, string, error) {
+	var bundle *crypto.MEKBundle
+	var decoyMEK []byte
+	var phrase string
+	var err error
+
+	switch {
+	case duressPassword != "":
+		bundle, decoyMEK, phrase, err = crypto.CreateDuressBundle(password, duressPassword)
+	case backupKeyring:
+		bundle, phrase, err = crypto.CreateMEKBundleWithKeyringBackup(password)
+	default:
+		bundle, phrase, err = crypto.CreateMEKBundle(password)
+	}
 	if err != nil {
 		return nil, "", err
 	}
@@ -58,17 +138,45 @@ func Create(path, password string) (*Vault, string, error) {
 		return nil, "", err
 	}
 
+	var encDataDuress []byte
+	if decoyMEK != nil {
+		decoyJSON, err := json.Marshal(&VaultData{Entries: make(EntryList, 0)})
+		if err != nil {
+			crypto.ZeroMemory(mekBytes)
+			crypto.ZeroMemory(decoyMEK)
+			return nil, "", err
+		}
+		// The decoy's own AAD only depends on header fields that don't
+		// differ from the real vault's, so BuildAAD() on the not-yet-built
+		// db below is fine to reuse for it too -- but db doesn't exist yet,
+		// so encrypt without AAD binding here and bind it once db exists.
+		encDataDuress, err = crypto.Encrypt(decoyJSON, decoyMEK)
+		crypto.ZeroMemory(decoyMEK)
+		if err != nil {
+			crypto.ZeroMemory(mekBytes)
+			return nil, "", err
+		}
+	}
+
 	// Build DB first so BuildAAD() is available
 	keyringFingerprint := crypto.GetKeyringFingerprint()
-	db := NewDatabase(
+	db, err := NewDatabase(
 		bundle.SaltPassword,
 		bundle.SaltPhrase,
 		bundle.EncryptedMEKPassword,
 		bundle.EncryptedMEKPhrase,
 		nil, // encrypted data will be set below
+		bundle.SaltDuress,
+		bundle.EncryptedMEKDuress,
+		encDataDuress,
+		bundle.EncryptedKeyringSecret,
 		SecurityModePasswordKeyring,
 		keyringFingerprint,
 	)
+	if err != nil {
+		crypto.ZeroMemory(mekBytes)
+		return nil, "", err
+	}
 
 	dataJSON, err := json.Marshal(data)
 	if err != nil {
@@ -95,18 +203,39 @@ func Create(path, password string) (*Vault, string, error) {
 	mek := crypto.NewSecureMEK(mekBytes)
 
 	vault := &Vault{
-		path:     path,
-		mek:      mek,
-		db:       db,
-		data:     data,
-		modified: false,
+		path:          path,
+		mek:           mek,
+		db:            db,
+		data:          data,
+		modified:      false,
+		saveDebounce:  DefaultSaveDebounce,
+		debounceClock: realDebounceClock{},
 	}
 
 	return vault, phrase, nil
 }
 
-// UnlockWithPassword unlocks an existing vault with the master password.
+// UnlockWithPassword unlocks an existing vault with the master password. A
+// per-vault backoff limiter (see crypto.UnlockLimiterFor) slows down repeated
+// wrong-password attempts, so a stolen vault file can't be brute-forced at
+// full speed even offline.
 func UnlockWithPassword(path, password string) (*Vault, error) {
+	limiter := crypto.UnlockLimiterFor(path)
+	limiter.Wait()
+
+	v, err := unlockWithPassword(path, password)
+	if err != nil {
+		if errors.Is(err, ErrWrongPassword) {
+			_ = limiter.RecordFailure()
+		}
+		return nil, err
+	}
+
+	_ = limiter.RecordSuccess()
+	return v, nil
+}
+
+func unlockWithPassword(path, password string) (*Vault, error) {
 	db, err := LoadDatabase(path)
 	if err != nil {
 		if errors.Is(err, ErrDatabaseNotFound) {
@@ -143,7 +272,10 @@ func UnlockWithPassword(path, password string) (*Vault, error) {
 	mekBytes, err := crypto.DecryptMEK(encMEK, key)
 	if err != nil {
 		if errors.Is(err, crypto.ErrMEKDecryptionFailed) {
-			return nil, ErrWrongPassword
+			if v, derr := unlockDuress(db, path, password); derr == nil {
+				return v, nil
+			}
+			return nil, classifyUnlockFailure(db, kerr, ErrWrongPassword)
 		}
 		return nil, err
 	}
@@ -151,18 +283,89 @@ func UnlockWithPassword(path, password string) (*Vault, error) {
 	data, err := decryptVaultData(db, mekBytes)
 	if err != nil {
 		crypto.ZeroMemory(mekBytes)
-		return nil, err
+		return nil, &UnlockError{Reason: ReasonCorruptVault, Err: err}
 	}
 
 	// Wrap MEK in secure memory (this wipes mekBytes)
 	mek := crypto.NewSecureMEK(mekBytes)
 
 	return &Vault{
-		path:     path,
-		mek:      mek,
-		db:       db,
-		data:     data,
-		modified: false,
+		path:          path,
+		mek:           mek,
+		db:            db,
+		data:          data,
+		modified:      false,
+		saveDebounce:  DefaultSaveDebounce,
+		debounceClock: realDebounceClock{},
+	}, nil
+}
+
+// unlockDuress tries password against db's duress slot, the same way
+// UnlockEither tries it against a MEKBundle's -- derived directly from the
+// password without the keyring, since the duress slot is never mixed with
+// it. Every database has a duress slot (see newDuressFields), so this always
+// runs regardless of whether a duress password was ever actually
+// configured; against an unconfigured one it simply fails like a wrong
+// password would, which keeps "no duress password set" indistinguishable
+// from "duress password didn't match" from outside.
+func unlockDuress(db *Database, path, password string) (*Vault, error) {
+	saltDuress, err := db.GetSaltDuress()
+	if err != nil {
+		return nil, err
+	}
+	encMEKDuress, err := db.GetEncryptedMEKDuress()
+	if err != nil {
+		return nil, err
+	}
+
+	key := crypto.DeriveKey([]byte(password), saltDuress)
+	defer crypto.ZeroMemory(key)
+
+	mekBytes, err := crypto.DecryptMEK(encMEKDuress, key)
+	if err != nil {
+		return nil, err
+	}
+
+	encDataDuress, err := db.GetEncryptedDataDuress()
+	if err != nil {
+		crypto.ZeroMemory(mekBytes)
+		return nil, err
+	}
+
+	// Try AAD-authenticated decryption first (what saveLocked writes after
+	// the first save), falling back to the plain encryption createVault
+	// seeds a freshly-created decoy with.
+	aad := db.BuildAAD()
+	dataJSON, err := crypto.DecryptWithAAD(encDataDuress, mekBytes, aad)
+	if err != nil {
+		dataJSON, err = crypto.Decrypt(encDataDuress, mekBytes)
+		if err != nil {
+			crypto.ZeroMemory(mekBytes)
+			return nil, err
+		}
+	}
+	defer crypto.ZeroMemory(dataJSON)
+
+	var data VaultData
+	if err := json.Unmarshal(dataJSON, &data); err != nil {
+		crypto.ZeroMemory(mekBytes)
+		return nil, err
+	}
+	if data.Entries == nil {
+		data.Entries = make(EntryList, 0)
+	}
+
+	mek := crypto.NewSecureMEK(mekBytes)
+
+	return &Vault{
+		path:          path,
+		mek:           mek,
+		db:            db,
+		data:          &data,
+		modified:      false,
+		usingDuress:   true,
+		saveDebounce:  DefaultSaveDebounce,
+		debounceClock: realDebounceClock{},
 	}, nil
 }
 
@@ -178,7 +381,7 @@ func UnlockWithPhrase(path, phrase string) (*Vault, error) {
 
 	phraseKey, err := crypto.PhraseToKey(phrase)
 	if err != nil {
-		return nil, ErrWrongPhrase
+		return nil, &UnlockError{Reason: ReasonWrongCredentials, Err: ErrWrongPhrase}
 	}
 	defer crypto.ZeroMemory(phraseKey)
 
@@ -190,7 +393,7 @@ func UnlockWithPhrase(path, phrase string) (*Vault, error) {
 	mekBytes, err := crypto.DecryptMEK(encMEK, phraseKey)
 	if err != nil {
 		if errors.Is(err, crypto.ErrMEKDecryptionFailed) {
-			return nil, ErrWrongPhrase
+			return nil, &UnlockError{Reason: ReasonWrongCredentials, Err: ErrWrongPhrase}
 		}
 		return nil, err
 	}
@@ -198,21 +401,47 @@ func UnlockWithPhrase(path, phrase string) (*Vault, error) {
 	data, err := decryptVaultData(db, mekBytes)
 	if err != nil {
 		crypto.ZeroMemory(mekBytes)
-		return nil, err
+		return nil, &UnlockError{Reason: ReasonCorruptVault, Err: err}
 	}
 
+	restoreKeyringSecret(db, phrase)
+
 	// Wrap MEK in secure memory (this wipes mekBytes)
 	mek := crypto.NewSecureMEK(mekBytes)
 
 	return &Vault{
-		path:     path,
-		mek:      mek,
-		db:       db,
-		data:     data,
-		modified: false,
+		path:          path,
+		mek:           mek,
+		db:            db,
+		data:          data,
+		modified:      false,
+		saveDebounce:  DefaultSaveDebounce,
+		debounceClock: realDebounceClock{},
 	}, nil
 }
 
+// restoreKeyringSecret puts db's backed-up keyring secret (if any) back into
+// the OS keyring after a successful phrase unlock, so a reinstall that wiped
+// the keyring doesn't force the user to keep using the recovery phrase for
+// every subsequent unlock. Best-effort: a vault created without the backup
+// option, or a keyring that's unavailable or already holds a secret, is left
+// alone rather than treated as an unlock failure.
+func restoreKeyringSecret(db *Database, phrase string) {
+	encKeyringSecret, err := db.GetEncryptedKeyringSecret()
+	if err != nil || len(encKeyringSecret) == 0 {
+		return
+	}
+
+	bundle := &crypto.MEKBundle{EncryptedKeyringSecret: encKeyringSecret}
+	secret, err := bundle.RecoverKeyringSecret(phrase)
+	if err != nil {
+		return
+	}
+	defer crypto.ZeroMemory(secret)
+
+	_ = crypto.StoreKeyringSecret(secret)
+}
+
 // decryptVaultData decrypts the vault data using the MEK.
 // Tries AAD-authenticated decryption first, falls back to legacy for migration.
 func decryptVaultData(db *Database, mek []byte) (*VaultData, error) {
@@ -253,7 +482,30 @@ func (v *Vault) Save() error {
 	return v.saveLocked()
 }
 
+// RequirePhraseAcknowledgement enables or disables the policy that blocks
+// Save (and MarkDirty/Flush's debounced save) until AcknowledgePhrase has
+// been called, so a new vault can't silently persist before the user has
+// confirmed they recorded its recovery phrase.
+func (v *Vault) RequirePhraseAcknowledgement(required bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.requirePhraseAck = required
+}
+
+// AcknowledgePhrase records that the user has confirmed saving their
+// recovery phrase, lifting the RequirePhraseAcknowledgement block on Save.
+// Once set, it stays set for the lifetime of this Vault instance.
+func (v *Vault) AcknowledgePhrase() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.phraseAcknowledged = true
+}
+
 func (v *Vault) saveLocked() error {
+	if v.requirePhraseAck && !v.phraseAcknowledged {
+		return ErrPhraseNotAcknowledged
+	}
+
 	dataJSON, err := json.Marshal(v.data)
 	if err != nil {
 		return err
@@ -272,9 +524,23 @@ func (v *Vault) saveLocked() error {
 		return err
 	}
 
-	v.db.SetEncryptedData(encData)
+	if v.usingDuress {
+		v.db.SetEncryptedDataDuress(encData)
+	} else {
+		v.db.SetEncryptedData(encData)
+	}
+	v.db.TouchMeta()
+
+	if v.inMemory {
+		v.modified = false
+		return nil
+	}
 
-	if err := SaveDatabase(v.db, v.path); err != nil {
+	if v.backupBeforeSave {
+		if err := SaveDatabaseWithBackup(v.db, v.path); err != nil {
+			return err
+		}
+	} else if err := SaveDatabase(v.db, v.path); err != nil {
 		return err
 	}
 
@@ -287,11 +553,23 @@ func (v *Vault) Lock() {
 	v.mu.Lock()
 	defer v.mu.Unlock()
 
+	if v.saveTimer != nil {
+		v.saveTimer.Stop()
+		v.saveTimer = nil
+	}
+	if v.modified {
+		_ = v.saveLocked()
+	}
+
 	if v.mek != nil {
 		v.mek.Destroy()
 		v.mek = nil
 	}
 	v.data = nil
+
+	if v.clipboard != nil {
+		_ = v.clipboard.Clear()
+	}
 }
 
 // IsLocked returns true if the vault is locked.
@@ -313,6 +591,18 @@ func (v *Vault) Path() string {
 	return v.path
 }
 
+// KDFConfig returns the key derivation parameters protecting this vault,
+// for display in a settings/audit screen.
+func (v *Vault) KDFConfig() *KDFConfig {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if v.db.KDF == nil {
+		return CurrentKDFConfig()
+	}
+	return v.db.KDF
+}
+
 // Entries returns a copy of all entries.
 func (v *Vault) Entries() EntryList {
 	v.mu.RLock()
@@ -493,6 +783,74 @@ func (v *Vault) ChangePassword(oldPassword, newPassword string) error {
 	return v.saveLocked()
 }
 
+// SetDuressPassword verifies currentPassword against this vault before
+// configuring duressPassword as its duress password: from then on, entering
+// duressPassword instead of currentPassword at the login screen unlocks a
+// fresh, empty decoy vault instead of this one (see UnlockWithPassword).
+// This overwrites any duress password configured previously. Returns
+// ErrWrongPassword if currentPassword is wrong, or ErrVaultLocked if this
+// Vault instance itself is already locked. Not available on a Vault already
+// opened via its own duress password.
+func (v *Vault) SetDuressPassword(currentPassword, duressPassword string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.mek == nil || v.mek.IsDestroyed() {
+		return ErrVaultLocked
+	}
+	if v.usingDuress {
+		return ErrVaultLocked
+	}
+
+	salt, err := v.db.GetSaltPassword()
+	if err != nil {
+		return err
+	}
+	encMEK, err := v.db.GetEncryptedMEKPassword()
+	if err != nil {
+		return err
+	}
+
+	keyringSecret, kerr := crypto.GetKeyringSecret()
+	if keyringSecret != nil {
+		defer crypto.ZeroMemory(keyringSecret)
+	}
+
+	var key []byte
+	if kerr == nil && keyringSecret != nil {
+		key = crypto.DeriveKeyWithKeyring([]byte(currentPassword), salt, keyringSecret)
+	} else {
+		key = crypto.DeriveKey([]byte(currentPassword), salt)
+	}
+	defer crypto.ZeroMemory(key)
+
+	if _, err := crypto.DecryptMEK(encMEK, key); err != nil {
+		return ErrWrongPassword
+	}
+
+	bundle := &crypto.MEKBundle{}
+	decoyMEK, err := bundle.SetDuressPassword(duressPassword)
+	if err != nil {
+		return err
+	}
+	defer crypto.ZeroMemory(decoyMEK)
+
+	decoyJSON, err := json.Marshal(&VaultData{Entries: make(EntryList, 0)})
+	if err != nil {
+		return err
+	}
+
+	encDataDuress, err := crypto.EncryptWithAAD(decoyJSON, decoyMEK, v.db.BuildAAD())
+	if err != nil {
+		return err
+	}
+
+	v.db.SetDuressSlot(bundle.SaltDuress, bundle.EncryptedMEKDuress)
+	v.db.SetEncryptedDataDuress(encDataDuress)
+
+	return v.saveLocked()
+}
+
 // SetNewPassword sets a new master password without requiring the old password.
 // This is used after phrase-based recovery where the old password is not available.
 func (v *Vault) SetNewPassword(newPassword string) error {
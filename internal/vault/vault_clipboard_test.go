@@ -0,0 +1,37 @@
+package vault
+
+import (
+	"testing"
+	"time"
+
+	"github.com/atotto/clipboard"
+
+	"github.com/batterdaysahead/cipher0/internal/utils"
+)
+
+func TestVaultLockClearsClipboard(t *testing.T) {
+	cm := utils.NewClipboardManager(time.Hour)
+	if err := cm.Copy("secret-password"); err != nil {
+		t.Skipf("Clipboard not available: %v", err)
+	}
+
+	v := &Vault{}
+	v.SetClipboardManager(cm)
+	v.Lock()
+
+	got, err := clipboard.ReadAll()
+	if err != nil {
+		t.Skipf("Clipboard not available: %v", err)
+	}
+	if got != "" {
+		t.Errorf("Expected clipboard to be cleared on lock, got %q", got)
+	}
+}
+
+func TestVaultLockWithoutClipboardManager(t *testing.T) {
+	v := &Vault{}
+	v.Lock()
+	if !v.IsLocked() {
+		t.Error("Expected vault to be locked")
+	}
+}
@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/batterdaysahead/cipher0/internal/config"
+)
+
+func TestReadMetaRoundTrips(t *testing.T) {
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "vault.db")
+
+	v, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	v.Lock()
+
+	meta, err := ReadMeta(vaultPath)
+	if err != nil {
+		t.Fatalf("ReadMeta failed: %v", err)
+	}
+
+	if meta.CreatedByVersion != config.AppVersion {
+		t.Errorf("Expected CreatedByVersion %q, got %q", config.AppVersion, meta.CreatedByVersion)
+	}
+	if meta.CreatedAt.IsZero() {
+		t.Error("Expected CreatedAt to be set")
+	}
+	if meta.LastModified.Before(meta.CreatedAt) {
+		t.Error("Expected LastModified to be at or after CreatedAt")
+	}
+}
+
+func TestReadMetaUpdatesLastModifiedOnSave(t *testing.T) {
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "vault.db")
+
+	v, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	first, err := ReadMeta(vaultPath)
+	if err != nil {
+		t.Fatalf("ReadMeta failed: %v", err)
+	}
+
+	time.Sleep(time.Millisecond)
+	if err := v.AddEntry(NewEntry("Test")); err != nil {
+		t.Fatalf("AddEntry failed: %v", err)
+	}
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	second, err := ReadMeta(vaultPath)
+	if err != nil {
+		t.Fatalf("ReadMeta failed: %v", err)
+	}
+
+	if !second.LastModified.After(first.LastModified) {
+		t.Error("Expected LastModified to advance after a save")
+	}
+	if second.CreatedAt != first.CreatedAt {
+		t.Error("Expected CreatedAt to stay unchanged across saves")
+	}
+}
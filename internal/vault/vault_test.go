@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -104,7 +105,7 @@ func TestWrongPassword(t *testing.T) {
 	vault.Lock()
 
 	_, err = UnlockWithPassword(vaultPath, "wrong-password")
-	if err != ErrWrongPassword {
+	if !errors.Is(err, ErrWrongPassword) {
 		t.Errorf("Expected ErrWrongPassword, got: %v", err)
 	}
 }
@@ -240,7 +241,7 @@ func TestChangePassword(t *testing.T) {
 
 	// Old password should not work
 	_, err = UnlockWithPassword(vaultPath, oldPassword)
-	if err != ErrWrongPassword {
+	if !errors.Is(err, ErrWrongPassword) {
 		t.Error("Old password should not work")
 	}
 
@@ -368,6 +369,33 @@ func TestPlaintextExport(t *testing.T) {
 	vault.Lock()
 }
 
+func TestLoadDatabaseRejectsWrongSaltSize(t *testing.T) {
+	resetMockKeyring()
+	tmpDir := t.TempDir()
+	vaultPath := filepath.Join(tmpDir, "test.vault")
+
+	vault, _, err := Create(vaultPath, "password")
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	vault.Lock()
+
+	db, err := LoadDatabase(vaultPath)
+	if err != nil {
+		t.Fatalf("LoadDatabase failed: %v", err)
+	}
+
+	// Corrupt the password salt to an incorrect length and re-save.
+	db.SaltPassword = "deadbeef"
+	if err := SaveDatabase(db, vaultPath); err != nil {
+		t.Fatalf("SaveDatabase failed: %v", err)
+	}
+
+	if _, err := LoadDatabase(vaultPath); err != ErrIncompatibleVault {
+		t.Errorf("Expected ErrIncompatibleVault, got: %v", err)
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && contains(s[1:], substr) || s[:len(substr)] == substr)
 }